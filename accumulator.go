@@ -0,0 +1,67 @@
+package decimal
+
+// Accumulator collects running statistics of a stream of decimal numbers. The
+// sum is kept exact, rounding is only performed when average is requested.
+// Zero value is an empty accumulator ready to use.
+type Accumulator struct {
+	count int
+	sum   Number
+	min   Number
+	max   Number
+}
+
+// Add adds n to the accumulated values.
+func (a *Accumulator) Add(n Number) {
+	if a.count == 0 {
+		a.sum, a.min, a.max = n, n, n
+		a.count = 1
+		return
+	}
+
+	a.count++
+	a.sum = a.sum.Add(n)
+	if n.Cmp(a.min) < 0 {
+		a.min = n
+	}
+	if n.Cmp(a.max) > 0 {
+		a.max = n
+	}
+}
+
+// Count returns number of accumulated values.
+func (a *Accumulator) Count() int {
+	return a.count
+}
+
+// Sum returns exact sum of accumulated values, zero if no values were added.
+func (a *Accumulator) Sum() Number {
+	if a.count == 0 {
+		return Zero()
+	}
+	return a.sum
+}
+
+// Min returns the smallest accumulated value, zero if no values were added.
+func (a *Accumulator) Min() Number {
+	if a.count == 0 {
+		return Zero()
+	}
+	return a.min
+}
+
+// Max returns the largest accumulated value, zero if no values were added.
+func (a *Accumulator) Max() Number {
+	if a.count == 0 {
+		return Zero()
+	}
+	return a.max
+}
+
+// Avg returns average of accumulated values rounded to the given exponent
+// using the given rounding rule. Average of no values is zero.
+func (a *Accumulator) Avg(exp int, rule RoundRule) Number {
+	if a.count == 0 {
+		return Round(Zero(), exp, rule)
+	}
+	return divRound(a.sum, FromInt(a.count), exp, rule)
+}
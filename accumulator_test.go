@@ -0,0 +1,45 @@
+package decimal
+
+import (
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccumulatorEmpty(t *testing.T) {
+	var a Accumulator
+	assert.Equal(t, 0, a.Count())
+	assert.True(t, a.Sum().IsZero())
+	assert.True(t, a.Min().IsZero())
+	assert.True(t, a.Max().IsZero())
+	assert.Equal(t, newDecimal.New(0, -2), a.Avg(-2, RoundMath))
+}
+
+func TestAccumulator(t *testing.T) {
+	var a Accumulator
+	for _, s := range []string{"1.10", "-2.5", "0.001", "10"} {
+		n, err := FromString(s)
+		assert.NoError(t, err)
+		a.Add(n)
+	}
+
+	assert.Equal(t, 4, a.Count())
+	assert.Equal(t, newDecimal.New(8601, -3), a.Sum())
+	assert.Equal(t, newDecimal.New(-25, -1), a.Min())
+	assert.Equal(t, newDecimal.New(10, 0), a.Max())
+	assert.Equal(t, newDecimal.New(215, -2), a.Avg(-2, RoundMath))
+	assert.Equal(t, newDecimal.New(215025, -5), a.Avg(-5, RoundMath))
+	assert.Equal(t, newDecimal.New(2, 0), a.Avg(0, RoundTruncate))
+}
+
+func TestAccumulatorRoundsOnlyOnRead(t *testing.T) {
+	var a Accumulator
+	for i := 0; i < 3; i++ {
+		a.Add(New(4, -3))
+	}
+
+	// rounding every value to -2 would give 0.00
+	assert.Equal(t, newDecimal.New(1, -2), Round(a.Sum(), -2, RoundMath))
+	assert.Equal(t, newDecimal.New(4, -3), a.Avg(-3, RoundMath))
+}
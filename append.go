@@ -0,0 +1,91 @@
+package decimal
+
+import (
+	"strconv"
+
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// appendBufSize is the initial buffer capacity used by marshaling methods.
+// It fits typical monetary amounts and odds without reallocation.
+const appendBufSize = 24
+
+// AppendText appends text representation of n to dst and returns the
+// extended buffer. The representation is the same as returned by n.String(),
+// but no intermediate strings are allocated.
+func AppendText(dst []byte, n Number) []byte {
+	c := n.Coefficient()
+	if c.Sign() == 0 {
+		return append(dst, '0')
+	}
+	if c.Sign() < 0 {
+		dst = append(dst, '-')
+		c.Neg(c)
+	}
+
+	start := len(dst)
+	if c.IsInt64() {
+		dst = strconv.AppendInt(dst, c.Int64(), 10)
+	} else {
+		dst = c.Append(dst, 10)
+	}
+
+	exp := int(n.Exponent())
+	if exp >= 0 {
+		for i := 0; i < exp; i++ {
+			dst = append(dst, '0')
+		}
+		return dst
+	}
+
+	// strip trailing fractional zeros, at least one non-zero digit is present
+	digits, frac := len(dst)-start, -exp
+	for frac > 0 && dst[len(dst)-1] == '0' {
+		dst = dst[:len(dst)-1]
+		digits--
+		frac--
+	}
+	if frac == 0 {
+		return dst
+	}
+
+	if digits > frac {
+		// insert decimal point between integer and fractional digits
+		point := len(dst) - frac
+		dst = append(dst, 0)
+		copy(dst[point+1:], dst[point:])
+		dst[point] = '.'
+		return dst
+	}
+
+	// prepend "0." and leading fractional zeros
+	shift := 2 + frac - digits
+	for i := 0; i < shift; i++ {
+		dst = append(dst, 0)
+	}
+	copy(dst[start+shift:], dst[start:start+digits])
+	dst[start], dst[start+1] = '0', '.'
+	for i := start + 2; i < start+shift; i++ {
+		dst[i] = '0'
+	}
+	return dst
+}
+
+// String returns string representation of n, equal to n.String(). Numbers
+// with coefficients fitting int64 are formatted with strconv into a stack
+// buffer, only the returned string is allocated.
+func String(n Number) string {
+	var buf [appendBufSize]byte
+	return string(AppendText(buf[:0], n))
+}
+
+// AppendJSON appends JSON representation of n to dst and returns the extended
+// buffer. The representation is the same as returned by n.MarshalJSON().
+func AppendJSON(dst []byte, n Number) []byte {
+	if newDecimal.MarshalJSONWithoutQuotes {
+		return AppendText(dst, n)
+	}
+	dst = append(dst, '"')
+	dst = AppendText(dst, n)
+	return append(dst, '"')
+}
@@ -0,0 +1,81 @@
+package decimal
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendText(t *testing.T) {
+	coefficients := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(-1),
+		big.NewInt(10),
+		big.NewInt(1234),
+		big.NewInt(-1234),
+		big.NewInt(120000),
+		big.NewInt(-1000500),
+		big.NewInt(math.MaxInt64),
+		big.NewInt(math.MinInt64),
+		new(big.Int).Lsh(big.NewInt(1), 100),
+		new(big.Int).Mul(big.NewInt(-1), new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil)),
+	}
+
+	for _, c := range coefficients {
+		for exp := int32(-25); exp <= 3; exp++ {
+			n := newDecimal.NewFromBigInt(c, exp)
+			assert.Equal(t, n.String(), string(AppendText(nil, n)), fmt.Sprintf("%s * 10^%d", c, exp))
+			assert.Equal(t, n.String(), String(n), fmt.Sprintf("%s * 10^%d", c, exp))
+		}
+	}
+
+	assert.Equal(t, "0", string(AppendText(nil, Number{})))
+	assert.Equal(t, "x=-12.34", string(AppendText([]byte("x="), New(-1234, -2))))
+}
+
+func TestAppendJSON(t *testing.T) {
+	assert.Equal(t, `[1.5`, string(AppendJSON([]byte(`[`), New(15, -1))))
+
+	newDecimal.MarshalJSONWithoutQuotes = false
+	defer func() { newDecimal.MarshalJSONWithoutQuotes = true }()
+
+	assert.Equal(t, `"1.5"`, string(AppendJSON(nil, New(15, -1))))
+}
+
+func BenchmarkString(b *testing.B) {
+	d := New(1234, -2)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = String(d)
+	}
+}
+
+func BenchmarkExternalString(b *testing.B) {
+	d := New(1234, -2)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = d.String()
+	}
+}
+
+func BenchmarkAppendJSON(b *testing.B) {
+	d := New(123456789, -6)
+	buf := make([]byte, 0, appendBufSize)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendJSON(buf[:0], d)
+	}
+}
+
+func BenchmarkExternalMarshalJSON(b *testing.B) {
+	d := New(123456789, -6)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = d.MarshalJSON()
+	}
+}
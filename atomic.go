@@ -0,0 +1,57 @@
+package decimal
+
+import (
+	"sync"
+)
+
+// Atomic holds a decimal number that can be updated concurrently from
+// multiple goroutines. Zero value holds zero and is ready to use. Atomic must
+// not be copied after first use.
+type Atomic struct {
+	mu sync.Mutex
+	n  Number
+}
+
+// Load returns the current value, zero value Atomic holds initialized zero.
+func (a *Atomic) Load() Number {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return ensureInitialized(a.n)
+}
+
+// Store replaces the current value with n.
+func (a *Atomic) Store(n Number) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.n = n
+}
+
+// Swap replaces the current value with n and returns the previous value.
+func (a *Atomic) Swap(n Number) Number {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	old := ensureInitialized(a.n)
+	a.n = n
+	return old
+}
+
+// Add adds delta to the current value and returns the new value.
+func (a *Atomic) Add(delta Number) Number {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.n = a.n.Add(delta)
+	return a.n
+}
+
+// CompareAndSwap replaces the current value with n if it is numerically
+// equal to old, exponents of the numbers are not compared. It returns true if
+// the value was replaced.
+func (a *Atomic) CompareAndSwap(old, n Number) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !a.n.Equal(old) {
+		return false
+	}
+	a.n = n
+	return true
+}
@@ -0,0 +1,53 @@
+package decimal
+
+import (
+	"sync"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAtomic(t *testing.T) {
+	var a Atomic
+	assert.True(t, a.Load().IsZero())
+
+	a.Store(New(150, -2))
+	assert.Equal(t, newDecimal.New(150, -2), a.Load())
+
+	assert.Equal(t, newDecimal.New(175, -2), a.Add(New(25, -2)))
+	assert.Equal(t, newDecimal.New(175, -2), a.Swap(New(2, 0)))
+	assert.Equal(t, newDecimal.New(2, 0), a.Load())
+
+	assert.False(t, a.CompareAndSwap(New(1, 0), New(3, 0)))
+	assert.Equal(t, newDecimal.New(2, 0), a.Load())
+	assert.True(t, a.CompareAndSwap(New(200, -2), New(3, 0)))
+	assert.Equal(t, newDecimal.New(3, 0), a.Load())
+}
+
+func TestAtomicZeroValue(t *testing.T) {
+	n := new(Atomic).Load()
+	assert.NotNil(t, n.Coefficient())
+	assert.Equal(t, Zero(), n)
+	assert.NotEqual(t, Number{}, n)
+
+	assert.Equal(t, Zero(), new(Atomic).Swap(One))
+}
+
+func TestAtomicConcurrentAdd(t *testing.T) {
+	var a Atomic
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				a.Add(New(1, -2))
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, newDecimal.New(1000, -2), a.Load())
+}
@@ -0,0 +1,27 @@
+package decimal
+
+import (
+	"math/big"
+)
+
+// ToBigFloat converts decimal number to big.Float having the given precision
+// in bits, rounding to nearest even if the number is not representable.
+// Precision 0 is handled as in big.Float.SetRat. Uninitialized Number{} is
+// treated as zero.
+func ToBigFloat(n Number, prec uint) *big.Float {
+	return new(big.Float).SetPrec(prec).SetRat(n.Rat())
+}
+
+// FromBigFloat converts big.Float to a decimal number rounded to the given
+// exponent using the given rounding rule. The second return value reports if
+// the conversion is exact, i.e. no digits were lost by rounding. It panics if
+// f is infinite.
+func FromBigFloat(f *big.Float, exp int, rule RoundRule) (Number, bool) {
+	if f.IsInf() {
+		panic("decimal conversion of infinite big.Float")
+	}
+
+	r, _ := f.Rat(nil)
+	n := ratRound(r, exp, rule)
+	return n, n.Rat().Cmp(r) == 0
+}
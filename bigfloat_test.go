@@ -0,0 +1,69 @@
+package decimal
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToBigFloat(t *testing.T) {
+	tests := []struct {
+		n        string
+		prec     uint
+		expected string
+	}{
+		{"0", 53, "0"},
+		{"1.5", 53, "1.5"},
+		{"-123.456", 53, "-123.456"},
+		{"0.1", 53, "0.1"},
+		{"0.1", 8, "0.1"},
+		{"12345678901234567890.125", 200, "1.2345678901234567890125e+19"},
+		{"1e400", 64, "1e+400"},
+	}
+
+	for _, test := range tests {
+		f := ToBigFloat(newDecimal.RequireFromString(test.n), test.prec)
+		assert.Equal(t, test.prec, f.Prec(), test.n)
+		assert.Equal(t, test.expected, f.Text('g', -1), test.n)
+	}
+
+	assert.Equal(t, "0.10009765625", ToBigFloat(New(1, -1), 8).Text('g', 20))
+	assert.Equal(t, 0, ToBigFloat(Number{}, 53).Sign())
+	assert.Equal(t, 0, big.NewFloat(0.1).Cmp(ToBigFloat(New(1, -1), 53)))
+}
+
+func TestFromBigFloat(t *testing.T) {
+	tests := []struct {
+		f        *big.Float
+		exp      int
+		rule     RoundRule
+		expected Number
+		exact    bool
+	}{
+		{big.NewFloat(0), -2, RoundTruncate, New(0, -2), true},
+		{big.NewFloat(1.5), -2, RoundTruncate, New(150, -2), true},
+		{big.NewFloat(-1.125), -3, RoundBankers, New(-1125, -3), true},
+		{big.NewFloat(-1.125), -2, RoundBankers, New(-112, -2), false},
+		{big.NewFloat(-1.125), -2, RoundMath, New(-113, -2), false},
+		{big.NewFloat(-1.125), -2, RoundFloor, New(-113, -2), false},
+		{big.NewFloat(-1.125), -2, RoundCeil, New(-112, -2), false},
+		{big.NewFloat(0.1), -2, RoundMath, New(10, -2), false},
+		{big.NewFloat(1024), 1, RoundMath, New(102, 1), false},
+		{big.NewFloat(1020), 1, RoundMath, New(102, 1), true},
+		{new(big.Float).SetMantExp(big.NewFloat(1), -60), -60, RoundTruncate,
+			newDecimal.RequireFromString("0.000000000000000000867361737988403547205962240695953369140625").Round(60), true},
+	}
+
+	for _, test := range tests {
+		n, exact := FromBigFloat(test.f, test.exp, test.rule)
+		assert.Equal(t, test.expected, n, test.f.String())
+		assert.Equal(t, test.exact, exact, test.f.String())
+	}
+
+	assert.Panics(t, func() {
+		FromBigFloat(big.NewFloat(math.Inf(1)), 0, RoundTruncate)
+	})
+}
@@ -0,0 +1,131 @@
+package decimal
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// Bounds limits numbers accepted by decoders, decoded numbers exceeding them
+// fail with ErrOutOfBounds. Exponent limits are enforced only when their
+// flags are set, so zero exponent limits can be expressed, e.g. MaxExponent 0
+// rejects 1e3 and MinExponent 0 accepts integers only. Zero value accepts all
+// numbers.
+type Bounds struct {
+	// MaxExponent is the largest accepted exponent if LimitMaxExponent is
+	// set, e.g. 3 accepts 1e3 but rejects 1e4.
+	MaxExponent      int
+	LimitMaxExponent bool
+	// MinExponent is the smallest accepted exponent if LimitMinExponent is
+	// set, e.g. -8 accepts numbers having up to 8 decimal places.
+	MinExponent      int
+	LimitMinExponent bool
+	// MaxCoefficientDigits is the largest accepted number of coefficient
+	// digits, trailing zeros included. Zero disables the limit.
+	MaxCoefficientDigits int
+}
+
+// Check checks if n is within the bounds. Returned error matches
+// ErrOutOfBounds.
+func (b Bounds) Check(n Number) error {
+	exp := int(n.Exponent())
+	if b.LimitMaxExponent && exp > b.MaxExponent {
+		return fmt.Errorf("%w: exponent %d is above %d", ErrOutOfBounds, exp, b.MaxExponent)
+	}
+	if b.LimitMinExponent && exp < b.MinExponent {
+		return fmt.Errorf("%w: exponent %d is below %d", ErrOutOfBounds, exp, b.MinExponent)
+	}
+	if b.MaxCoefficientDigits > 0 {
+		// |coef| < 10^maxDigits
+		coef := n.Coefficient()
+		if coef.Abs(coef).Cmp(pow10(int64(b.MaxCoefficientDigits))) >= 0 {
+			return fmt.Errorf("%w: coefficient has more than %d digits", ErrOutOfBounds, b.MaxCoefficientDigits)
+		}
+	}
+	return nil
+}
+
+// DecodeOptions holds process-wide options of UnmarshalText, UnmarshalJSON
+// and Scan methods of Percent, BasisPoints, Extended, Optional and Numbers
+// values and UnmarshalJSON methods of StringNumber and PlainNumber values.
+// Methods of Number and NullNumber are implemented by shopspring/decimal and
+// are not affected. Prefer Config methods for untrusted input, Config has its
+// own options and does not use these.
+type DecodeOptions struct {
+	// Bounds limits decoded numbers.
+	Bounds Bounds
+	// FloatPrecision selects treatment of bare JSON numbers exceeding
+	// float64 precision by UnmarshalJSON, JSON strings are never checked.
+	// With FloatPrecisionWarn the decoded number and the error are reported
+	// to OnFloatPrecision if it is set.
+	FloatPrecision   FloatPrecisionPolicy
+	OnFloatPrecision func(n Number, err error)
+}
+
+// decodeOptions holds the current DecodeOptions value.
+var decodeOptions atomic.Value
+
+// SetDecodeOptions replaces process-wide decoding options, see DecodeOptions.
+// It is safe to call concurrently with decoding, but is meant to be called
+// once at startup.
+func SetDecodeOptions(o DecodeOptions) {
+	decodeOptions.Store(o)
+}
+
+// CurrentDecodeOptions returns process-wide decoding options, zero value
+// unless they were replaced with SetDecodeOptions.
+func CurrentDecodeOptions() DecodeOptions {
+	o, _ := decodeOptions.Load().(DecodeOptions)
+	return o
+}
+
+// FloatPrecisionPolicy is enum type for specifying treatment of bare JSON
+// numbers that do not survive a round trip through float64, see
+// IsFloat64Exact. Such numbers often come from producers that parse JSON
+// into float64 and have rounded the value already.
+type FloatPrecisionPolicy int
+
+// List of supported float precision policies
+const (
+	FloatPrecisionIgnore FloatPrecisionPolicy = iota // Accept the number
+	FloatPrecisionWarn                               // Accept the number and report it to the callback
+	FloatPrecisionError                              // Fail with ErrPrecisionLoss
+)
+
+// checkBounds checks if n is within the process-wide decoding bounds.
+func checkBounds(n Number) error {
+	return CurrentDecodeOptions().Bounds.Check(n)
+}
+
+// checkFloatPrecision applies the policy to n decoded from JSON data. JSON
+// strings and null are not checked.
+func checkFloatPrecision(n Number, data []byte, policy FloatPrecisionPolicy, warn func(Number, error)) error {
+	if policy == FloatPrecisionIgnore || len(data) == 0 || data[0] == '"' || data[0] == 'n' || IsFloat64Exact(n) {
+		return nil
+	}
+	err := fmt.Errorf("%w: JSON number %s exceeds float64 precision", ErrPrecisionLoss, data)
+	if policy == FloatPrecisionError {
+		return err
+	}
+	if warn != nil {
+		warn(n, err)
+	}
+	return nil
+}
+
+// unmarshalJSON decodes JSON number into n and checks the process-wide
+// decoding bounds and float precision policy. On failure n is left unchanged.
+func unmarshalJSON(n *Number, data []byte) error {
+	var v Number
+	if err := v.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	o := CurrentDecodeOptions()
+	if err := o.Bounds.Check(v); err != nil {
+		return err
+	}
+	if err := checkFloatPrecision(v, data, o.FloatPrecision, o.OnFloatPrecision); err != nil {
+		return err
+	}
+	*n = v
+	return nil
+}
@@ -0,0 +1,133 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBounds(t *testing.T) {
+	minExp := func(exp int) Bounds { return Bounds{MinExponent: exp, LimitMinExponent: true} }
+	maxExp := func(exp int) Bounds { return Bounds{MaxExponent: exp, LimitMaxExponent: true} }
+	tests := []struct {
+		n      string
+		bounds Bounds
+		ok     bool
+	}{
+		{"12.34", Bounds{}, true},
+		{"1e2147483647", Bounds{}, true},
+		{"12.34", minExp(-2), true},
+		{"12.345", minExp(-2), false},
+		{"1e3", maxExp(3), true},
+		{"1e4", maxExp(3), false},
+		{"1.5", maxExp(0), true},
+		{"1e3", maxExp(0), false},
+		{"12", minExp(0), true},
+		{"1.2", minExp(0), false},
+		{"999999", Bounds{MaxCoefficientDigits: 6}, true},
+		{"-999999", Bounds{MaxCoefficientDigits: 6}, true},
+		{"1000000", Bounds{MaxCoefficientDigits: 6}, false},
+		{"-9999.99", Bounds{MaxCoefficientDigits: 6}, true},
+		{"1.000000", Bounds{MaxCoefficientDigits: 6}, false},
+		{"0", Bounds{MinExponent: -2, LimitMinExponent: true, MaxExponent: 2, LimitMaxExponent: true, MaxCoefficientDigits: 1}, true},
+		// exponent values are ignored without their flags
+		{"1.234", Bounds{MinExponent: -2}, true},
+	}
+
+	for _, test := range tests {
+		n := newDecimal.RequireFromString(test.n)
+		err := test.bounds.Check(n)
+		if test.ok {
+			assert.NoError(t, err, test.n)
+		} else {
+			assert.ErrorIs(t, err, ErrOutOfBounds, test.n)
+		}
+	}
+
+	assert.EqualError(t, minExp(-2).Check(New(1, -3)), "decimal: number out of bounds: exponent -3 is below -2")
+	assert.NoError(t, Bounds{MinExponent: -2, LimitMinExponent: true, MaxCoefficientDigits: 1}.Check(Number{}))
+}
+
+func TestPackageBounds(t *testing.T) {
+	defer SetDecodeOptions(CurrentDecodeOptions())
+	SetDecodeOptions(DecodeOptions{Bounds: Bounds{
+		MinExponent:          -4,
+		LimitMinExponent:     true,
+		MaxExponent:          6,
+		LimitMaxExponent:     true,
+		MaxCoefficientDigits: 12,
+	}})
+
+	var p Percent
+	assert.NoError(t, json.Unmarshal([]byte(`12.5`), &p))
+	assert.ErrorIs(t, json.Unmarshal([]byte(`1e100`), &p), ErrOutOfBounds)
+	assert.ErrorIs(t, p.UnmarshalText([]byte(`0.00001%`)), ErrOutOfBounds)
+	assert.Equal(t, newDecimal.New(125, -1), p.Number())
+
+	var b BasisPoints
+	assert.ErrorIs(t, json.Unmarshal([]byte(`"1234567890123"`), &b), ErrOutOfBounds)
+	assert.ErrorIs(t, b.UnmarshalText([]byte(`1e7bp`)), ErrOutOfBounds)
+
+	// failed decoding leaves value unchanged
+	o := OptionalValue(One)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`1e-2147483648`), &o), ErrOutOfBounds)
+	assert.ErrorIs(t, o.UnmarshalText([]byte(`0.123456`)), ErrOutOfBounds)
+	assert.ErrorIs(t, o.Scan("1e9"), ErrOutOfBounds)
+	assert.Equal(t, OptionalValue(One), o)
+	var absent Optional
+	assert.Error(t, absent.UnmarshalText([]byte(`x`)))
+	assert.False(t, absent.Present)
+	assert.NoError(t, o.Scan(int64(5)))
+
+	var e Extended
+	assert.NoError(t, json.Unmarshal([]byte(`"NaN"`), &e))
+	assert.ErrorIs(t, json.Unmarshal([]byte(`0.000001`), &e), ErrOutOfBounds)
+	assert.ErrorIs(t, e.Scan([]byte("1e9")), ErrOutOfBounds)
+	assert.ErrorIs(t, e.Scan(1e9), ErrOutOfBounds)
+
+	var ns Numbers
+	assert.NoError(t, json.Unmarshal([]byte(`[1, 2.5]`), &ns))
+	assert.ErrorIs(t, json.Unmarshal([]byte(`[1, 2.55555]`), &ns), ErrOutOfBounds)
+	assert.Len(t, ns, 2)
+}
+
+func TestJSONFloatPrecision(t *testing.T) {
+	defer SetDecodeOptions(CurrentDecodeOptions())
+
+	var p PlainNumber
+	assert.NoError(t, json.Unmarshal([]byte(`12345678901234567890`), &p))
+
+	SetDecodeOptions(DecodeOptions{FloatPrecision: FloatPrecisionError})
+	err := json.Unmarshal([]byte(`9007199254740993`), &p)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	assert.EqualError(t, err, "decimal: precision loss: JSON number 9007199254740993 exceeds float64 precision")
+	assert.Equal(t, "12345678901234567890", p.String())
+
+	// JSON strings are not checked
+	assert.NoError(t, json.Unmarshal([]byte(`"9007199254740993"`), &p))
+	assert.NoError(t, json.Unmarshal([]byte(`0.1`), &p))
+
+	var o Optional
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &o))
+	assert.ErrorIs(t, json.Unmarshal([]byte(`1.00000000000000000001`), &o), ErrPrecisionLoss)
+	var ns Numbers
+	assert.ErrorIs(t, json.Unmarshal([]byte(`[1, 0.12345678901234567890]`), &ns), ErrPrecisionLoss)
+	assert.NoError(t, json.Unmarshal([]byte(`[1, "0.12345678901234567890"]`), &ns))
+	assert.Len(t, ns, 2)
+
+	var warned []Number
+	SetDecodeOptions(DecodeOptions{
+		FloatPrecision: FloatPrecisionWarn,
+		OnFloatPrecision: func(n Number, err error) {
+			assert.ErrorIs(t, err, ErrPrecisionLoss)
+			warned = append(warned, n)
+		},
+	})
+	var s StringNumber
+	assert.NoError(t, json.Unmarshal([]byte(`9007199254740993`), &s))
+	assert.NoError(t, json.Unmarshal([]byte(`0.5`), &s))
+	assert.Equal(t, []Number{New(9007199254740993, 0)}, warned)
+	assert.Equal(t, "0.5", s.String())
+}
@@ -0,0 +1,84 @@
+package decimal
+
+import (
+	"fmt"
+)
+
+// Calculation is a chain of arithmetic operations on a decimal number. The
+// first failing operation stores an error, all subsequent operations are
+// skipped and the error is returned by Result. Calculation is an immutable
+// value, every operation returns a new Calculation.
+//
+// Example:
+//
+//	payout, err := Calc(stake).Mul(odds).MulInt(legs).Div(total, -2, RoundBankers).Result()
+type Calculation struct {
+	n   Number
+	err error
+}
+
+// Calc starts a new calculation with an initial value n.
+func Calc(n Number) Calculation {
+	return Calculation{n: n}
+}
+
+// Add adds n to the current value.
+func (c Calculation) Add(n Number) Calculation {
+	return c.apply(func(v Number) Number { return v.Add(n) })
+}
+
+// Sub subtracts n from the current value.
+func (c Calculation) Sub(n Number) Calculation {
+	return c.apply(func(v Number) Number { return v.Sub(n) })
+}
+
+// Mul multiplies the current value by n.
+func (c Calculation) Mul(n Number) Calculation {
+	return c.apply(func(v Number) Number { return v.Mul(n) })
+}
+
+// MulInt multiplies the current value by an integer n.
+func (c Calculation) MulInt(n int) Calculation {
+	return c.apply(func(v Number) Number { return MulInt(v, n) })
+}
+
+// Div divides the current value by n, the quotient is rounded to the given
+// exponent using the given rounding rule. Division by zero fails the
+// calculation with ErrDivisionByZero.
+func (c Calculation) Div(n Number, exp int, rule RoundRule) Calculation {
+	if c.err == nil && n.IsZero() {
+		return Calculation{err: ErrDivisionByZero}
+	}
+	return c.apply(func(v Number) Number { return divRound(v, n, exp, rule) })
+}
+
+// Round rounds the current value to the given exponent using the given
+// rounding rule.
+func (c Calculation) Round(exp int, rule RoundRule) Calculation {
+	return c.apply(func(v Number) Number { return Round(v, exp, rule) })
+}
+
+// Result returns the calculated value or the error of the first failed
+// operation.
+func (c Calculation) Result() (Number, error) {
+	if c.err != nil {
+		return Number{}, c.err
+	}
+	return c.n, nil
+}
+
+// apply runs an operation unless calculation has already failed. Panics of
+// the underlying arithmetic (exponent overflows) are converted to ErrOverflow.
+func (c Calculation) apply(op func(Number) Number) (res Calculation) {
+	if c.err != nil {
+		return c
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			res = Calculation{err: fmt.Errorf("%w: %v, operand %s", ErrOverflow, r, DebugString(c.n))}
+		}
+	}()
+
+	return Calculation{n: op(c.n)}
+}
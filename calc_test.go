@@ -0,0 +1,55 @@
+package decimal
+
+import (
+	"math"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalc(t *testing.T) {
+	res, err := Calc(New(1000, -2)).
+		Mul(New(185, -2)).
+		MulInt(2).
+		Sub(New(5, 0)).
+		Add(New(1, -2)).
+		Div(New(3, 0), -3, RoundMath).
+		Round(-2, RoundBankers).
+		Result()
+
+	// ((10.00 * 1.85 * 2) - 5 + 0.01) / 3 = 10.67
+	assert.NoError(t, err)
+	assert.Equal(t, newDecimal.New(1067, -2), res)
+}
+
+func TestCalcDivisionByZero(t *testing.T) {
+	res, err := Calc(New(1, 0)).
+		Div(Zero(), -2, RoundMath).
+		Add(New(1, 0)).
+		Result()
+
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+	assert.True(t, res.IsZero())
+}
+
+func TestCalcOverflow(t *testing.T) {
+	_, err := Calc(New(1, math.MaxInt32)).
+		Mul(New(1, 1)).
+		Div(Zero(), 0, RoundMath).
+		Result()
+
+	assert.ErrorIs(t, err, ErrOverflow)
+	assert.Contains(t, err.Error(), "operand dec(coef=1, exp=2147483647)")
+}
+
+func TestCalcIsImmutable(t *testing.T) {
+	base := Calc(New(2, 0))
+	a, err := base.MulInt(3).Result()
+	assert.NoError(t, err)
+	b, err := base.Add(New(1, 0)).Result()
+	assert.NoError(t, err)
+
+	assert.Equal(t, newDecimal.New(6, 0), a)
+	assert.Equal(t, newDecimal.New(3, 0), b)
+}
@@ -0,0 +1,16 @@
+package decimal
+
+// CashOut calculates cash-out value of an open back bet, the stake times the
+// ratio of original and current decimal odds reduced by a margin factor:
+//
+//	stake * originalOdds / currentOdds * marginFactor
+//
+// e.g. margin factor 0.95 keeps 5% of the fair value. The exact value is
+// rounded once to the given exponent using the given rounding rule. It panics
+// if currentOdds is not positive.
+func CashOut(stake, originalOdds, currentOdds Number, marginFactor Number, exp int, rule RoundRule) Number {
+	if currentOdds.Sign() <= 0 {
+		panic("decimal: cash-out current odds must be positive: " + DebugString(currentOdds))
+	}
+	return divRound(stake.Mul(originalOdds).Mul(marginFactor), currentOdds, exp, rule)
+}
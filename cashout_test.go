@@ -0,0 +1,35 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCashOut(t *testing.T) {
+	tests := []struct {
+		stake, original, current, margin string
+		rule                             RoundRule
+		expected                         string
+	}{
+		// fair value without margin
+		{"10", "3", "1.5", "1", RoundBankers, "20"},
+		{"10", "3", "1.5", "0.95", RoundBankers, "19"},
+		// odds drifted, cash-out below stake
+		{"10", "2", "3", "0.95", RoundTruncate, "6.33"},
+		{"10", "2", "3", "0.95", RoundBankers, "6.33"},
+		{"10", "2.5", "1.1", "0.97", RoundTruncate, "22.04"},
+		{"10", "2.5", "1.1", "0.97", RoundMath, "22.05"},
+		{"0", "2", "3", "0.95", RoundBankers, "0"},
+	}
+
+	for _, test := range tests {
+		n := numbers(test.stake, test.original, test.current, test.margin)
+		actual := CashOut(n[0], n[1], n[2], n[3], -2, test.rule)
+		assert.Equal(t, test.expected, actual.String(), "%s @ %s now %s", test.stake, test.original, test.current)
+		assert.Equal(t, int32(-2), actual.Exponent())
+	}
+
+	assert.Panics(t, func() { CashOut(Ten, Two, Zero(), One, -2, RoundBankers) })
+	assert.Panics(t, func() { CashOut(Ten, Two, New(-2, 0), One, -2, RoundBankers) })
+}
@@ -0,0 +1,122 @@
+package decimal
+
+import (
+	"math/big"
+)
+
+// Cmp compares numbers x and y and returns:
+//
+//	-1 if x <  y
+//	 0 if x == y
+//	+1 if x >  y
+//
+// It is equivalent to x.Cmp(y), but avoids rescaling numbers when signs of
+// the numbers differ or both coefficients fit into int64. Uninitialized
+// Number{} is treated as zero.
+func Cmp(x, y Number) int {
+	xs, ys := x.Sign(), y.Sign()
+	if xs != ys {
+		return cmpInt(xs, ys)
+	}
+	if xs == 0 {
+		return 0
+	}
+	if x.Exponent() == y.Exponent() {
+		return x.Cmp(y)
+	}
+
+	xc, yc := x.Coefficient(), y.Coefficient()
+	return cmpScaled(xc, int64(x.Exponent()), yc, int64(y.Exponent()))
+}
+
+// Compare is Cmp with a name and signature suitable for slices.SortFunc and
+// slices.BinarySearchFunc. Uninitialized Number{} is treated as zero.
+func Compare(a, b Number) int {
+	return Cmp(a, b)
+}
+
+// EqualApprox checks if a and b differ by no more than tolerance, i.e.
+// |a - b| <= tolerance. The difference is calculated exactly. Uninitialized
+// Number{} is treated as zero.
+func EqualApprox(a, b, tolerance Number) bool {
+	return Cmp(a.Sub(b).Abs(), tolerance) <= 0
+}
+
+// WithinPercent checks if a and b differ by no more than pct percent of the
+// larger of their magnitudes, i.e. |a - b| <= pct/100 * max(|a|, |b|). The
+// check is symmetric and calculated exactly, two zeros are always within any
+// non-negative percentage. Uninitialized Number{} is treated as zero.
+func WithinPercent(a, b, pct Number) bool {
+	ref := a.Abs()
+	if bAbs := b.Abs(); Cmp(bAbs, ref) > 0 {
+		ref = bAbs
+	}
+	return Cmp(a.Sub(b).Abs().Mul(Hundred), ref.Mul(pct)) <= 0
+}
+
+// CmpInt64 compares number n with an integer v and returns:
+//
+//	-1 if n <  v
+//	 0 if n == v
+//	+1 if n >  v
+//
+// Uninitialized Number{} is treated as zero.
+func CmpInt64(n Number, v int64) int {
+	ns, vs := n.Sign(), 0
+	if v > 0 {
+		vs = 1
+	} else if v < 0 {
+		vs = -1
+	}
+	if ns != vs {
+		return cmpInt(ns, vs)
+	}
+	if ns == 0 {
+		return 0
+	}
+
+	return cmpScaled(n.Coefficient(), int64(n.Exponent()), big.NewInt(v), 0)
+}
+
+// cmpScaled compares xc * 10^xe and yc * 10^ye, both coefficients must have
+// the same non-zero sign. Arguments might be modified.
+func cmpScaled(xc *big.Int, xe int64, yc *big.Int, ye int64) int {
+	// rescale the number with the higher exponent to the lower one
+	swap := xe < ye
+	if swap {
+		xc, xe, yc, ye = yc, ye, xc, xe
+	}
+
+	var c int
+	if xc.IsInt64() && yc.IsInt64() {
+		if xv, ok := rescaleInt64(xc.Int64(), ye-xe); ok {
+			c = cmpInt64(xv, yc.Int64())
+		} else {
+			// scaled x magnitude does not fit into int64 so it is larger
+			// than y magnitude
+			c = xc.Sign()
+		}
+	} else {
+		c = xc.Mul(xc, pow10(xe-ye)).Cmp(yc)
+	}
+
+	if swap {
+		return -c
+	}
+	return c
+}
+
+func cmpInt(x, y int) int {
+	return cmpInt64(int64(x), int64(y))
+}
+
+func cmpInt64(x, y int64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,157 @@
+package decimal
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmp(t *testing.T) {
+	values := []Number{
+		{},
+		newDecimal.New(0, -3),
+		newDecimal.New(0, 5),
+		newDecimal.New(1, 0),
+		newDecimal.New(10, -1),
+		newDecimal.New(-1, 0),
+		newDecimal.New(-100, -2),
+		newDecimal.New(15, -1),
+		newDecimal.New(-15, -1),
+		newDecimal.New(1, 18),
+		newDecimal.New(1, 19),
+		newDecimal.New(1, -30),
+		newDecimal.New(math.MaxInt64, 0),
+		newDecimal.New(math.MaxInt64, -1),
+		newDecimal.New(math.MinInt64, 0),
+		newDecimal.New(math.MinInt64, 2),
+		newDecimal.New(123456789, -400),
+		newDecimal.NewFromBigInt(new(big.Int).Lsh(big.NewInt(1), 100), -3),
+		newDecimal.NewFromBigInt(new(big.Int).Lsh(big.NewInt(-1), 100), 3),
+	}
+
+	for _, x := range values {
+		for _, y := range values {
+			assert.Equal(t, x.Cmp(y), Cmp(x, y), fmt.Sprintf("%s cmp %s", x, y))
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	assert.Equal(t, 0, Compare(Number{}, New(0, -3)))
+	assert.Equal(t, -1, Compare(Number{}, New(1, -3)))
+	assert.Equal(t, 1, Compare(New(15, -1), Number{}))
+	assert.Equal(t, -1, Compare(New(149, -2), New(15, -1)))
+}
+
+func TestEqualApprox(t *testing.T) {
+	tests := []struct {
+		a, b, tolerance string
+		expected        bool
+	}{
+		{"1", "1.00", "0", true},
+		{"10.00", "10.01", "0.01", true},
+		{"10.01", "10.00", "0.01", true},
+		{"10.00", "10.02", "0.01", false},
+		{"-5.005", "-5", "0.005", true},
+		{"-5.0051", "-5", "0.005", false},
+		{"1", "-1", "2", true},
+		{"1", "1", "-0.01", false},
+		{"0.30000000000000000001", "0.3", "0.00000000000000000001", true},
+	}
+
+	for _, test := range tests {
+		a := newDecimal.RequireFromString(test.a)
+		b := newDecimal.RequireFromString(test.b)
+		tolerance := newDecimal.RequireFromString(test.tolerance)
+		assert.Equal(t, test.expected, EqualApprox(a, b, tolerance), "%s ~ %s ± %s", test.a, test.b, test.tolerance)
+	}
+
+	assert.True(t, EqualApprox(Number{}, New(1, -3), New(1, -3)))
+}
+
+func TestWithinPercent(t *testing.T) {
+	tests := []struct {
+		a, b, pct string
+		expected  bool
+	}{
+		{"100", "101", "1", true},
+		{"101", "100", "1", true},
+		{"100", "102", "1", false},
+		{"99", "100", "1", true},
+		{"98.99", "100", "1", false},
+		{"-100", "-101", "1", true},
+		{"100", "-100", "200", true},
+		{"100", "-100", "199.99", false},
+		{"0", "0", "0", true},
+		{"0", "0.01", "99", false},
+		{"0", "0.01", "100", true},
+		{"1000000", "1000001", "0.0001", true},
+	}
+
+	for _, test := range tests {
+		a := newDecimal.RequireFromString(test.a)
+		b := newDecimal.RequireFromString(test.b)
+		pct := newDecimal.RequireFromString(test.pct)
+		assert.Equal(t, test.expected, WithinPercent(a, b, pct), "%s ~ %s ± %s%%", test.a, test.b, test.pct)
+	}
+
+	assert.True(t, WithinPercent(Number{}, Number{}, Number{}))
+}
+
+func TestCmpInt64(t *testing.T) {
+	tests := []struct {
+		n        Number
+		v        int64
+		expected int
+	}{
+		{Number{}, 0, 0},
+		{newDecimal.New(0, -2), 0, 0},
+		{newDecimal.New(0, -2), 1, -1},
+		{newDecimal.New(0, -2), -1, 1},
+		{newDecimal.New(100, -2), 1, 0},
+		{newDecimal.New(101, -2), 1, 1},
+		{newDecimal.New(99, -2), 1, -1},
+		{newDecimal.New(-99, -2), -1, 1},
+		{newDecimal.New(-101, -2), -1, -1},
+		{newDecimal.New(1, 2), 100, 0},
+		{newDecimal.New(1, 2), 101, -1},
+		{newDecimal.New(1, 19), math.MaxInt64, 1},
+		{newDecimal.New(-1, 19), math.MinInt64, -1},
+		{newDecimal.New(math.MaxInt64, -1), math.MaxInt64, -1},
+		{newDecimal.NewFromBigInt(new(big.Int).Lsh(big.NewInt(1), 100), -3), math.MaxInt64, 1},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, CmpInt64(test.n, test.v), fmt.Sprintf("%s cmp %d", test.n, test.v))
+	}
+}
+
+func BenchmarkCmp(b *testing.B) {
+	x := newDecimal.New(15, -1)
+	y := newDecimal.New(1499, -3)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Cmp(x, y)
+	}
+}
+
+func BenchmarkExternalCmp(b *testing.B) {
+	x := newDecimal.New(15, -1)
+	y := newDecimal.New(1499, -3)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = x.Cmp(y)
+	}
+}
+
+func BenchmarkCmpInt64(b *testing.B) {
+	x := newDecimal.New(1500, -2)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = CmpInt64(x, 10)
+	}
+}
@@ -0,0 +1,17 @@
+package decimal
+
+// Commission calculates commission amount * rate limited to the range [min,
+// max] and rounds it once to the given exponent using the given rounding rule.
+// Rate is a plain factor, i.e. 0.05 for 5%. Zero max disables the ceiling.
+// Bounds are applied to the exact product, so a bound is returned as is
+// (rounded to the exponent) when it is hit.
+func Commission(amount, rate Number, min, max Number, exp int, rule RoundRule) Number {
+	c := amount.Mul(rate)
+	if !max.IsZero() && c.Cmp(max) > 0 {
+		c = max
+	}
+	if c.Cmp(min) < 0 {
+		c = min
+	}
+	return Round(c, exp, rule)
+}
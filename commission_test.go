@@ -0,0 +1,34 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommission(t *testing.T) {
+	rate := New(5, -2)
+	tests := []struct {
+		amount   Number
+		min      Number
+		max      Number
+		rule     RoundRule
+		expected string
+	}{
+		{New(1000, 0), Zero(), Zero(), RoundBankers, "50"},
+		{New(1000, 0), Zero(), New(25, 0), RoundBankers, "25"},
+		{New(10, 0), New(1, 0), New(25, 0), RoundBankers, "1"},
+		{New(30, 0), New(1, 0), New(25, 0), RoundBankers, "1.5"},
+		{New(3333, -2), Zero(), Zero(), RoundBankers, "1.67"},
+		{New(3333, -2), Zero(), Zero(), RoundTruncate, "1.66"},
+		// the product is clamped before rounding
+		{New(5019, -1), Zero(), New(25, 0), RoundCeil, "25"},
+		{New(-1000, 0), Zero(), Zero(), RoundBankers, "0"},
+	}
+
+	for _, test := range tests {
+		actual := Commission(test.amount, rate, test.min, test.max, -2, test.rule)
+		assert.Equal(t, test.expected, actual.String(), test.amount.String())
+		assert.Equal(t, int32(-2), actual.Exponent())
+	}
+}
@@ -0,0 +1,63 @@
+// Package compat implements conversions that keep calling code compiling
+// while the decimal package moves away from being an alias of
+// github.com/shopspring/decimal. Code that converts through this package
+// instead of relying on decimal.Number being a shopspring Decimal can be
+// migrated one call site at a time.
+//
+// Adapters for v1 function signatures and v1 behavioural shims are not
+// implemented yet: they are blocked until the v1 module
+// github.com/advbet/decimal can be fetched and tested against as published.
+// Until then values can be exchanged with v1 code through their exact string
+// or coefficient and exponent representation using Decompose and Compose.
+package compat
+
+import (
+	"math/big"
+
+	"github.com/advbet/decimal/v2"
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// FromShopspring converts shopspring decimal to a decimal number. The result
+// does not share the coefficient with d.
+func FromShopspring(d newDecimal.Decimal) decimal.Number {
+	return decimal.Copy(d)
+}
+
+// ToShopspring converts decimal number to shopspring decimal. The result
+// does not share the coefficient with n. Uninitialized Number{} is converted
+// to zero.
+func ToShopspring(n decimal.Number) newDecimal.Decimal {
+	return newDecimal.NewFromBigInt(n.Coefficient(), n.Exponent())
+}
+
+// FromNullShopspring converts nullable shopspring decimal to a nullable
+// decimal number.
+func FromNullShopspring(d newDecimal.NullDecimal) decimal.NullNumber {
+	if !d.Valid {
+		return decimal.NullNumber{}
+	}
+	return decimal.NullNumber{Decimal: FromShopspring(d.Decimal), Valid: true}
+}
+
+// ToNullShopspring converts nullable decimal number to nullable shopspring
+// decimal.
+func ToNullShopspring(n decimal.NullNumber) newDecimal.NullDecimal {
+	if !n.Valid {
+		return newDecimal.NullDecimal{}
+	}
+	return newDecimal.NullDecimal{Decimal: ToShopspring(n.Decimal), Valid: true}
+}
+
+// Decompose returns coefficient and exponent of n, n = coef * 10^exp. This
+// representation does not depend on the implementation of decimal.Number.
+// Returned coefficient is a copy.
+func Decompose(n decimal.Number) (coef *big.Int, exp int32) {
+	return n.Coefficient(), n.Exponent()
+}
+
+// Compose creates a decimal number coef * 10^exp. The result does not
+// reference coef.
+func Compose(coef *big.Int, exp int32) decimal.Number {
+	return newDecimal.NewFromBigInt(new(big.Int).Set(coef), exp)
+}
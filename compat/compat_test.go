@@ -0,0 +1,46 @@
+package compat
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShopspring(t *testing.T) {
+	d := newDecimal.New(1234, -2)
+	n := FromShopspring(d)
+	assert.True(t, n.Equal(decimal.New(1234, -2)))
+	assert.Equal(t, int32(-2), n.Exponent())
+	assert.True(t, ToShopspring(n).Equal(d))
+	assert.True(t, ToShopspring(decimal.Number{}).Equal(newDecimal.Zero))
+}
+
+func TestNullShopspring(t *testing.T) {
+	assert.Equal(t, decimal.NullNumber{}, FromNullShopspring(newDecimal.NullDecimal{}))
+	assert.Equal(t, newDecimal.NullDecimal{}, ToNullShopspring(decimal.NullNumber{}))
+
+	n := FromNullShopspring(newDecimal.NullDecimal{Decimal: newDecimal.New(15, -1), Valid: true})
+	assert.True(t, n.Valid)
+	assert.True(t, n.Decimal.Equal(decimal.New(15, -1)))
+	d := ToNullShopspring(n)
+	assert.True(t, d.Valid)
+	assert.True(t, d.Decimal.Equal(newDecimal.New(15, -1)))
+}
+
+func TestComposeDecompose(t *testing.T) {
+	coef, exp := Decompose(decimal.New(-1050, -3))
+	assert.Equal(t, big.NewInt(-1050), coef)
+	assert.Equal(t, int32(-3), exp)
+
+	n := Compose(coef, exp)
+	coef.SetInt64(1)
+	assert.True(t, n.Equal(decimal.New(-105, -2)))
+	assert.Equal(t, int32(-3), n.Exponent())
+
+	coef, exp = Decompose(decimal.Number{})
+	assert.Equal(t, 0, coef.Sign())
+	assert.Equal(t, int32(0), exp)
+}
@@ -0,0 +1,287 @@
+package decimal
+
+import (
+	"database/sql/driver"
+	"errors"
+	"strings"
+)
+
+var (
+	errNotPlain      = errors.New("not a plain decimal number")
+	errLeadingPlus   = errors.New("leading plus sign")
+	errEmptyInteger  = errors.New("missing integer digits")
+	errEmptyFraction = errors.New("missing fractional digits")
+)
+
+// ValueFormat is enum type for specifying type of database driver values
+// produced by Config.Value.
+type ValueFormat int
+
+// List of supported driver value formats
+const (
+	ValueString  ValueFormat = iota // Exact decimal string
+	ValueFloat64                    // Nearest float64, might lose precision
+)
+
+// DivZeroPolicy is enum type for specifying result of division by zero in
+// Config division methods.
+type DivZeroPolicy int
+
+// List of supported division by zero policies
+const (
+	DivZeroError    DivZeroPolicy = iota // Fail with ErrDivisionByZero
+	DivZeroZero                          // Return zero
+	DivZeroSentinel                      // Return Config.DivZeroSentinel
+)
+
+// SyntaxPolicy is enum type for specifying how Config.Parse treats a
+// non-canonical form of a number.
+type SyntaxPolicy int
+
+// List of supported syntax policies
+const (
+	SyntaxAccept    SyntaxPolicy = iota // Accept the form as is
+	SyntaxReject                        // Fail with *ParseError
+	SyntaxNormalize                     // Accept and rewrite in NormalizeInput
+)
+
+// Config holds formatting, parsing and arithmetic options that are otherwise
+// controlled by process-global variables. Config is an immutable value, two
+// subsystems can use different configurations concurrently.
+type Config struct {
+	// DivisionPrecision is the number of decimal places in the result of Div
+	// when it doesn't divide exactly.
+	DivisionPrecision int
+	// MarshalJSONWithoutQuotes selects JSON representation of numbers, bare
+	// JSON numbers if true, JSON strings otherwise.
+	MarshalJSONWithoutQuotes bool
+	// ValueFormat selects type of values returned by Value.
+	ValueFormat ValueFormat
+	// Strict makes Parse accept only plain decimal numbers matching
+	// -?[0-9]+(\.[0-9]+)?, rejecting scientific notation, leading plus sign
+	// and missing integer or fractional digits.
+	Strict bool
+	// LeadingPlus, EmptyInteger and EmptyFraction select treatment of
+	// inputs like "+1", ".5" and "1." respectively. Parsed numbers do not
+	// depend on the form, e.g. "1." and "1" parse to the same number, so
+	// SyntaxNormalize differs from SyntaxAccept only in NormalizeInput.
+	// Strict rejects all of these forms regardless of the policies.
+	LeadingPlus   SyntaxPolicy
+	EmptyInteger  SyntaxPolicy
+	EmptyFraction SyntaxPolicy
+	// DivZeroPolicy selects result of division by zero in DivRound, DivInt
+	// and Inv. Div panics on zero divisor only with DivZeroError policy.
+	DivZeroPolicy DivZeroPolicy
+	// DivZeroSentinel is the result of division by zero with
+	// DivZeroSentinel policy.
+	DivZeroSentinel Number
+	// Bounds limits numbers accepted by Parse, ParseJSON and Scan.
+	Bounds Bounds
+	// FloatPrecision selects treatment of bare JSON numbers exceeding
+	// float64 precision by ParseJSON. With FloatPrecisionWarn the parsed
+	// number and the error are reported to OnFloatPrecision if it is set.
+	FloatPrecision   FloatPrecisionPolicy
+	OnFloatPrecision func(n Number, err error)
+}
+
+// DefaultConfig returns configuration matching the package-level behaviour.
+func DefaultConfig() Config {
+	return Config{
+		DivisionPrecision:        16,
+		MarshalJSONWithoutQuotes: true,
+		ValueFormat:              ValueString,
+	}
+}
+
+// Parse creates a new instance of decimal number by parsing given string.
+// Returned error is a *ParseError or matches ErrOutOfBounds.
+func (c Config) Parse(str string) (Number, error) {
+	if c.Strict && !isPlain(str) {
+		return Number{}, &ParseError{Input: str, Err: errNotPlain}
+	}
+	if _, err := c.NormalizeInput(str); err != nil {
+		return Number{}, err
+	}
+	n, err := FromString(str)
+	if err != nil {
+		return Number{}, err
+	}
+	if err := c.checkBounds(n); err != nil {
+		return Number{}, err
+	}
+	return n, nil
+}
+
+// NormalizeInput checks str against the LeadingPlus, EmptyInteger and
+// EmptyFraction policies and returns it with forms having SyntaxNormalize
+// policy rewritten, e.g. "+.5" is rewritten to "0.5" and "1.e3" to "1e3".
+// Forms having SyntaxReject policy fail with *ParseError. Validity of the
+// rest of the number is not checked, use Parse for that.
+func (c Config) NormalizeInput(str string) (string, error) {
+	out := str
+	sign := ""
+	if len(out) > 0 && (out[0] == '+' || out[0] == '-') {
+		sign, out = out[:1], out[1:]
+	}
+	if sign == "+" {
+		switch c.LeadingPlus {
+		case SyntaxReject:
+			return "", &ParseError{Input: str, Err: errLeadingPlus}
+		case SyntaxNormalize:
+			sign = ""
+		}
+	}
+
+	point := strings.IndexByte(out, '.')
+	if point < 0 {
+		return sign + out, nil
+	}
+	if point == 0 {
+		switch c.EmptyInteger {
+		case SyntaxReject:
+			return "", &ParseError{Input: str, Err: errEmptyInteger}
+		case SyntaxNormalize:
+			out, point = "0"+out, 1
+		}
+	}
+	if end := point + 1; end == len(out) || out[end] == 'e' || out[end] == 'E' {
+		switch c.EmptyFraction {
+		case SyntaxReject:
+			return "", &ParseError{Input: str, Err: errEmptyFraction}
+		case SyntaxNormalize:
+			out = out[:point] + out[end:]
+		}
+	}
+	return sign + out, nil
+}
+
+// Format returns string representation of a decimal number.
+func (c Config) Format(n Number) string {
+	return n.String()
+}
+
+// Div calculates x / y. If it doesn't divide exactly, the result has
+// DivisionPrecision decimal places and is rounded half away from zero.
+// Division by zero panics with DivZeroError policy.
+func (c Config) Div(x, y Number) Number {
+	if y.IsZero() && c.DivZeroPolicy != DivZeroError {
+		n, _ := c.divZero()
+		return n
+	}
+	return x.DivRound(y, int32(c.DivisionPrecision))
+}
+
+// DivRound calculates x / y and rounds the quotient to the given exponent
+// using the given rounding rule. Division by zero is handled according to
+// DivZeroPolicy.
+func (c Config) DivRound(x, y Number, exp int, rule RoundRule) (Number, error) {
+	if y.IsZero() {
+		return c.divZero()
+	}
+	return divRound(x, y, exp, rule), nil
+}
+
+// DivInt calculates x / n, see DivRound for rounding and error handling.
+func (c Config) DivInt(x Number, n int, exp int, rule RoundRule) (Number, error) {
+	return c.DivRound(x, New(int64(n), 0), exp, rule)
+}
+
+// Inv calculates 1 / x, see DivRound for rounding and error handling.
+func (c Config) Inv(x Number, exp int, rule RoundRule) (Number, error) {
+	return c.DivRound(One, x, exp, rule)
+}
+
+// divZero returns result of division by zero according to DivZeroPolicy.
+func (c Config) divZero() (Number, error) {
+	switch c.DivZeroPolicy {
+	case DivZeroZero:
+		return Zero(), nil
+	case DivZeroSentinel:
+		return c.DivZeroSentinel, nil
+	default:
+		return Number{}, ErrDivisionByZero
+	}
+}
+
+// FormatJSON returns JSON representation of a decimal number.
+func (c Config) FormatJSON(n Number) ([]byte, error) {
+	if c.MarshalJSONWithoutQuotes {
+		return []byte(n.String()), nil
+	}
+	return []byte(`"` + n.String() + `"`), nil
+}
+
+// ParseJSON parses JSON number or JSON string holding a decimal number.
+// JSON null is unmarshaled as zero.
+func (c Config) ParseJSON(data []byte) (Number, error) {
+	str := string(data)
+	if str == "null" {
+		return Number{}, nil
+	}
+	if len(str) > 2 && str[0] == '"' && str[len(str)-1] == '"' {
+		str = str[1 : len(str)-1]
+	}
+	n, err := c.Parse(str)
+	if err != nil {
+		return Number{}, err
+	}
+	if err := checkFloatPrecision(n, data, c.FloatPrecision, c.OnFloatPrecision); err != nil {
+		return Number{}, err
+	}
+	return n, nil
+}
+
+// Value returns database driver value of a decimal number.
+func (c Config) Value(n Number) (driver.Value, error) {
+	if c.ValueFormat == ValueFloat64 {
+		return n.InexactFloat64(), nil
+	}
+	return n.String(), nil
+}
+
+// Scan converts database driver value to a decimal number. Text values are
+// parsed with Parse. Integers of any size, e.g. uint64 or *big.Int values
+// returned by some drivers for DECIMAL(20,0) columns, are converted exactly.
+func (c Config) Scan(value interface{}) (Number, error) {
+	switch v := value.(type) {
+	case []byte:
+		return c.Parse(string(v))
+	case string:
+		return c.Parse(v)
+	default:
+		n, err := scanValue(value)
+		if err != nil {
+			return Number{}, err
+		}
+		if err := c.checkBounds(n); err != nil {
+			return Number{}, err
+		}
+		return n, nil
+	}
+}
+
+// checkBounds checks if n is within the configured decoding bounds.
+func (c Config) checkBounds(n Number) error {
+	return c.Bounds.Check(n)
+}
+
+// isPlain checks if string matches -?[0-9]+(\.[0-9]+)? pattern.
+func isPlain(str string) bool {
+	if len(str) > 0 && str[0] == '-' {
+		str = str[1:]
+	}
+
+	digits, point := 0, -1
+	for i := 0; i < len(str); i++ {
+		switch {
+		case str[i] >= '0' && str[i] <= '9':
+			digits++
+		case str[i] == '.' && point == -1 && digits > 0:
+			point = i
+		default:
+			return false
+		}
+	}
+
+	return digits > 0 && point != len(str)-1
+}
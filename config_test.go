@@ -0,0 +1,251 @@
+package decimal
+
+import (
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigParse(t *testing.T) {
+	tests := []struct {
+		str    string
+		lax    bool
+		strict bool
+	}{
+		{"0", true, true},
+		{"-0", true, true},
+		{"12.34", true, true},
+		{"-12.34", true, true},
+		{"0012.3400", true, true},
+		{"+1", true, false},
+		{"1.", true, false},
+		{".5", true, false},
+		{"-.5", true, false},
+		{"1e3", true, false},
+		{".-2", true, false},
+		{"-", false, false},
+		{"", false, false},
+		{"1.2.3", false, false},
+		{" 1", false, false},
+	}
+
+	lax := DefaultConfig()
+	strict := DefaultConfig()
+	strict.Strict = true
+
+	for _, test := range tests {
+		_, err := lax.Parse(test.str)
+		assert.Equal(t, test.lax, err == nil, "lax "+test.str)
+		_, err = strict.Parse(test.str)
+		assert.Equal(t, test.strict, err == nil, "strict "+test.str)
+	}
+
+	n, err := strict.Parse("-12.340")
+	assert.NoError(t, err)
+	assert.Equal(t, newDecimal.New(-12340, -3), n)
+}
+
+func TestConfigBounds(t *testing.T) {
+	c := DefaultConfig()
+	c.Bounds = Bounds{
+		MinExponent:          -2,
+		LimitMinExponent:     true,
+		MaxExponent:          3,
+		LimitMaxExponent:     true,
+		MaxCoefficientDigits: 8,
+	}
+
+	n, err := c.Parse("123456.78")
+	assert.NoError(t, err)
+	assert.Equal(t, newDecimal.New(12345678, -2), n)
+
+	for _, str := range []string{"0.001", "1e4", "1234567.89", "1e-2147483648"} {
+		_, err = c.Parse(str)
+		assert.ErrorIs(t, err, ErrOutOfBounds, str)
+	}
+
+	_, err = c.ParseJSON([]byte(`"1e9"`))
+	assert.ErrorIs(t, err, ErrOutOfBounds)
+	_, err = c.Scan([]byte("0.125"))
+	assert.ErrorIs(t, err, ErrOutOfBounds)
+	_, err = c.Scan(0.125)
+	assert.ErrorIs(t, err, ErrOutOfBounds)
+	_, err = c.Scan(int64(1000))
+	assert.NoError(t, err)
+
+	// package-level bounds are not used by Config
+	_, err = DefaultConfig().Parse("1e-2147483648")
+	assert.NoError(t, err)
+}
+
+func TestConfigDiv(t *testing.T) {
+	c := DefaultConfig()
+	assert.Equal(t, "0.6666666666666667", c.Div(New(2, 0), New(3, 0)).String())
+
+	c.DivisionPrecision = 3
+	assert.Equal(t, "0.667", c.Div(New(2, 0), New(3, 0)).String())
+	assert.Equal(t, "-0.667", c.Div(New(-2, 0), New(3, 0)).String())
+}
+
+func TestConfigDivZeroPolicy(t *testing.T) {
+	c := DefaultConfig()
+	_, err := c.DivRound(One, Zero(), -2, RoundBankers)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+	_, err = c.DivInt(One, 0, -2, RoundBankers)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+	_, err = c.Inv(Zero(), -2, RoundBankers)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+	assert.Panics(t, func() { c.Div(One, Zero()) })
+
+	c.DivZeroPolicy = DivZeroZero
+	n, err := c.DivRound(One, Zero(), -2, RoundBankers)
+	assert.NoError(t, err)
+	assert.Equal(t, Zero(), n)
+	assert.Equal(t, Zero(), c.Div(One, Zero()))
+
+	c.DivZeroPolicy = DivZeroSentinel
+	c.DivZeroSentinel = New(-1, 0)
+	n, err = c.DivInt(One, 0, -2, RoundBankers)
+	assert.NoError(t, err)
+	assert.Equal(t, New(-1, 0), n)
+	n, err = c.Inv(Number{}, -2, RoundBankers)
+	assert.NoError(t, err)
+	assert.Equal(t, New(-1, 0), n)
+	assert.Equal(t, New(-1, 0), c.Div(One, Zero()))
+
+	n, err = c.DivRound(New(2, 0), New(3, 0), -2, RoundBankers)
+	assert.NoError(t, err)
+	assert.Equal(t, New(67, -2), n)
+}
+
+func TestConfigJSON(t *testing.T) {
+	c := DefaultConfig()
+	blob, err := c.FormatJSON(New(123456, -3))
+	assert.NoError(t, err)
+	assert.Equal(t, `123.456`, string(blob))
+
+	c.MarshalJSONWithoutQuotes = false
+	blob, err = c.FormatJSON(New(123456, -3))
+	assert.NoError(t, err)
+	assert.Equal(t, `"123.456"`, string(blob))
+
+	for _, in := range []string{`123.456`, `"123.456"`} {
+		n, err := c.ParseJSON([]byte(in))
+		assert.NoError(t, err)
+		assert.Equal(t, newDecimal.New(123456, -3), n)
+	}
+
+	n, err := c.ParseJSON([]byte(`null`))
+	assert.NoError(t, err)
+	assert.True(t, n.IsZero())
+
+	c.Strict = true
+	_, err = c.ParseJSON([]byte(`"1e3"`))
+	assert.Error(t, err)
+}
+
+func TestConfigFloatPrecision(t *testing.T) {
+	c := DefaultConfig()
+	_, err := c.ParseJSON([]byte(`9007199254740993`))
+	assert.NoError(t, err)
+
+	c.FloatPrecision = FloatPrecisionError
+	_, err = c.ParseJSON([]byte(`9007199254740993`))
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	_, err = c.ParseJSON([]byte(`"9007199254740993"`))
+	assert.NoError(t, err)
+
+	c.FloatPrecision = FloatPrecisionWarn
+	var warned []Number
+	c.OnFloatPrecision = func(n Number, err error) { warned = append(warned, n) }
+	n, err := c.ParseJSON([]byte(`9007199254740993`))
+	assert.NoError(t, err)
+	assert.Equal(t, New(9007199254740993, 0), n)
+	assert.Equal(t, []Number{n}, warned)
+
+	// package-level policy is not used by Config
+	c = DefaultConfig()
+	defer SetDecodeOptions(CurrentDecodeOptions())
+	SetDecodeOptions(DecodeOptions{FloatPrecision: FloatPrecisionError})
+	_, err = c.ParseJSON([]byte(`9007199254740993`))
+	assert.NoError(t, err)
+}
+
+func TestConfigValueScan(t *testing.T) {
+	c := DefaultConfig()
+	val, err := c.Value(New(123, -1))
+	assert.NoError(t, err)
+	assert.Equal(t, "12.3", val)
+
+	c.ValueFormat = ValueFloat64
+	val, err = c.Value(New(123, -1))
+	assert.NoError(t, err)
+	assert.Equal(t, 12.3, val)
+
+	n, err := c.Scan([]byte("0.015"))
+	assert.NoError(t, err)
+	assert.Equal(t, newDecimal.New(15, -3), n)
+
+	n, err = c.Scan(int64(5))
+	assert.NoError(t, err)
+	assert.Equal(t, newDecimal.New(5, 0), n)
+
+	c.Strict = true
+	_, err = c.Scan("+5")
+	assert.Error(t, err)
+}
+
+func TestConfigSyntaxPolicy(t *testing.T) {
+	tests := []struct {
+		input      string
+		policy     SyntaxPolicy
+		normalized string
+		err        string
+	}{
+		{"+1", SyntaxAccept, "+1", ""},
+		{"+1", SyntaxNormalize, "1", ""},
+		{"+1", SyntaxReject, "", `decimal: can't parse "+1": leading plus sign`},
+		{".5", SyntaxAccept, ".5", ""},
+		{"-.5", SyntaxNormalize, "-0.5", ""},
+		{".5", SyntaxReject, "", `decimal: can't parse ".5": missing integer digits`},
+		{"1.", SyntaxAccept, "1.", ""},
+		{"1.", SyntaxNormalize, "1", ""},
+		{"1.e3", SyntaxNormalize, "1e3", ""},
+		{"1.", SyntaxReject, "", `decimal: can't parse "1.": missing fractional digits`},
+		{"+.5", SyntaxNormalize, "0.5", ""},
+		{"-1.5", SyntaxReject, "-1.5", ""},
+		{"1.5e-3", SyntaxReject, "1.5e-3", ""},
+	}
+
+	for _, test := range tests {
+		c := DefaultConfig()
+		c.LeadingPlus, c.EmptyInteger, c.EmptyFraction = test.policy, test.policy, test.policy
+
+		normalized, err := c.NormalizeInput(test.input)
+		n, perr := c.Parse(test.input)
+		if test.err != "" {
+			assert.EqualError(t, err, test.err, test.input)
+			assert.EqualError(t, perr, test.err, test.input)
+			assert.ErrorIs(t, perr, ErrParse, test.input)
+			continue
+		}
+		assert.NoError(t, err, test.input)
+		assert.Equal(t, test.normalized, normalized, test.input)
+		assert.NoError(t, perr, test.input)
+
+		// parsed number does not depend on the form
+		expected, err := FromString(normalized)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, n, test.input)
+	}
+
+	// policies are independent
+	c := DefaultConfig()
+	c.LeadingPlus = SyntaxReject
+	n, err := c.Parse(".5")
+	assert.NoError(t, err)
+	assert.Equal(t, New(5, -1), n)
+	_, err = c.Parse("+.5")
+	assert.Error(t, err)
+}
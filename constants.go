@@ -0,0 +1,51 @@
+package decimal
+
+import (
+	"fmt"
+
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// Frequently used decimal numbers. These values are shared, they must not be
+// reassigned or modified in place, e.g. through unsafe access to their
+// coefficient. Zero function returns a fresh zero value, ZeroValue is its
+// shared counterpart.
+var (
+	ZeroValue = New(0, 0)
+	One       = New(1, 0)
+	Two       = New(2, 0)
+	Ten       = New(10, 0)
+	Hundred   = New(100, 0)
+	Thousand  = New(1000, 0)
+	OneCent   = New(1, -2)
+)
+
+// MaxConstantPrecision is the largest number of decimal places supported by Pi
+// and E.
+const MaxConstantPrecision = 100
+
+// Digits of mathematical constants, 20 guard digits beyond
+// MaxConstantPrecision make rounding to nearest correct.
+var (
+	pi = newDecimal.RequireFromString("3.141592653589793238462643383279502884197169399375105820974944592307816406286208998628034825342117067982148086513282306647")
+	e  = newDecimal.RequireFromString("2.718281828459045235360287471352662497757247093699959574966967627724076630353547594571382178525166427427466391932003059921")
+)
+
+// Pi returns the number π correctly rounded to the given exponent. It panics
+// if exp is below -MaxConstantPrecision.
+func Pi(exp int) Number {
+	return roundConstant(pi, exp)
+}
+
+// E returns the Euler's number e correctly rounded to the given exponent. It
+// panics if exp is below -MaxConstantPrecision.
+func E(exp int) Number {
+	return roundConstant(e, exp)
+}
+
+func roundConstant(c Number, exp int) Number {
+	if exp < -MaxConstantPrecision {
+		panic(fmt.Sprintf("decimal: constant precision of %d decimal places exceeds %d", -exp, MaxConstantPrecision))
+	}
+	return Round(c, exp, RoundMath)
+}
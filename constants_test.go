@@ -0,0 +1,79 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstants(t *testing.T) {
+	tests := []struct {
+		n   Number
+		str string
+		exp int32
+	}{
+		{ZeroValue, "0", 0},
+		{One, "1", 0},
+		{Two, "2", 0},
+		{Ten, "10", 0},
+		{Hundred, "100", 0},
+		{Thousand, "1000", 0},
+		{OneCent, "0.01", -2},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.str, test.n.String())
+		assert.Equal(t, test.exp, test.n.Exponent())
+	}
+
+	assert.Equal(t, Zero(), ZeroValue)
+}
+
+func TestConstantsNotModified(t *testing.T) {
+	_ = One.Add(Two).Mul(Ten).Sub(OneCent)
+	_ = Round(Hundred.Shift(-3), 0, RoundCeil)
+	_ = MulInt(Thousand, 7)
+	c := OneCent.Coefficient()
+	c.SetInt64(42)
+
+	assert.Equal(t, "1", One.String())
+	assert.Equal(t, "2", Two.String())
+	assert.Equal(t, "10", Ten.String())
+	assert.Equal(t, "100", Hundred.String())
+	assert.Equal(t, "1000", Thousand.String())
+	assert.Equal(t, "0.01", OneCent.String())
+}
+
+func TestPiE(t *testing.T) {
+	tests := []struct {
+		exp int
+		pi  string
+		e   string
+	}{
+		{1, "0", "0"},
+		{0, "3", "3"},
+		{-1, "3.1", "2.7"},
+		{-2, "3.14", "2.72"},
+		{-4, "3.1416", "2.7183"},
+		{-10, "3.1415926536", "2.7182818285"},
+		{-20, "3.14159265358979323846", "2.71828182845904523536"},
+		// π rounds up to ...170680, String omits the trailing zero
+		{-100,
+			"3.141592653589793238462643383279502884197169399375105820974944592307816406286208998628034825342117068",
+			"2.7182818284590452353602874713526624977572470936999595749669676277240766303535475945713821785251664274"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.pi, Pi(test.exp).String(), test.exp)
+		assert.Equal(t, test.e, E(test.exp).String(), test.exp)
+		assert.Equal(t, int32(test.exp), Pi(test.exp).Exponent())
+	}
+
+	assert.Panics(t, func() { Pi(-101) })
+	assert.Panics(t, func() { E(-101) })
+
+	// results are fresh values
+	p := Pi(-2)
+	p.Coefficient().SetInt64(0)
+	assert.Equal(t, "3.14", Pi(-2).String())
+}
@@ -0,0 +1,177 @@
+package decimal
+
+import (
+	"math/big"
+	"sync"
+)
+
+// RateExp is the exponent derived exchange rates (inverse and cross rates) are
+// rounded to by RateTable.
+const RateExp = -10
+
+// currencyExp maps ISO 4217 currency codes to the exponent of their minor
+// unit. Currencies using two decimal places are the most common ones, only a
+// selection of them is listed.
+var currencyExp = map[string]int{
+	"AUD": -2, "BGN": -2, "BRL": -2, "CAD": -2, "CHF": -2, "CNY": -2,
+	"CZK": -2, "DKK": -2, "EUR": -2, "GBP": -2, "GEL": -2, "HKD": -2,
+	"HUF": -2, "ILS": -2, "INR": -2, "MXN": -2, "NOK": -2, "NZD": -2,
+	"PHP": -2, "PLN": -2, "RON": -2, "RSD": -2, "RUB": -2, "SEK": -2,
+	"SGD": -2, "THB": -2, "TRY": -2, "UAH": -2, "USD": -2, "ZAR": -2,
+
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0,
+	"KMF": 0, "KRW": 0, "PYG": 0, "RWF": 0, "UGX": 0, "VND": 0,
+	"VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+
+	"BHD": -3, "IQD": -3, "JOD": -3, "KWD": -3, "LYD": -3, "OMR": -3,
+	"TND": -3,
+}
+
+// CurrencyExponent returns exponent of the minor unit for a given ISO 4217
+// currency code, e.g. -2 for EUR and 0 for JPY.
+func CurrencyExponent(currency string) (int, bool) {
+	exp, ok := currencyExp[currency]
+	return exp, ok
+}
+
+// Convert calculates amount * rate and rounds the result to the minor unit of
+// the target currency using the given rounding rule. The product is calculated
+// exactly, rounding is performed only once.
+func Convert(amount Number, rate Number, targetCurrency string, rule RoundRule) (Number, error) {
+	exp, ok := CurrencyExponent(targetCurrency)
+	if !ok {
+		return Number{}, ErrUnknownCurrency
+	}
+	if !rate.IsPositive() {
+		return Number{}, ErrInvalidRate
+	}
+
+	return Round(amount.Mul(rate), exp, rule), nil
+}
+
+type currencyPair struct {
+	base  string
+	quote string
+}
+
+// RateTable holds exchange rates between currencies. Rates that are not set
+// directly are derived in the following order:
+//
+//  1. Same currency rate is always 1.
+//  2. Direct rate base/quote is used exactly as it was set.
+//  3. Inverse rate 1/(quote/base) is rounded to RateExp using RoundBankers.
+//  4. Cross rate base/pivot * pivot/quote, where each leg is a direct or an
+//     exact unrounded inverse rate, is multiplied exactly and then rounded
+//     once to RateExp using RoundBankers, as CrossRate does.
+//
+// Converting an amount rounds the product of amount and rate once to the
+// minor unit of the target currency. RateTable is safe for concurrent use.
+type RateTable struct {
+	mu    sync.RWMutex
+	pivot string
+	rates map[currencyPair]Number
+}
+
+// NewRateTable creates an empty rate table. Cross rates are triangulated via
+// the pivot currency, empty pivot disables cross rates.
+func NewRateTable(pivot string) *RateTable {
+	return &RateTable{
+		pivot: pivot,
+		rates: make(map[currencyPair]Number),
+	}
+}
+
+// Set stores exchange rate for a currency pair, i.e. amount of quote currency
+// units one unit of base currency is worth.
+func (t *RateTable) Set(base, quote string, rate Number) error {
+	if !rate.IsPositive() {
+		return ErrInvalidRate
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.rates[currencyPair{base, quote}] = rate
+	return nil
+}
+
+// Rate returns exchange rate from base to quote currency, see RateTable
+// documentation for the rate derivation rules.
+func (t *RateTable) Rate(base, quote string) (Number, error) {
+	if base == quote {
+		return One, nil
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if rate, ok := t.rates[currencyPair{base, quote}]; ok {
+		return rate, nil
+	}
+	if inverse, ok := t.exactLeg(base, quote); ok {
+		return ratRound(inverse, RateExp, RoundBankers), nil
+	}
+
+	if t.pivot == "" || base == t.pivot || quote == t.pivot {
+		return Number{}, ErrUnknownRate
+	}
+
+	first, ok := t.exactLeg(base, t.pivot)
+	if !ok {
+		return Number{}, ErrUnknownRate
+	}
+	second, ok := t.exactLeg(t.pivot, quote)
+	if !ok {
+		return Number{}, ErrUnknownRate
+	}
+
+	return ratRound(first.Mul(first, second), RateExp, RoundBankers), nil
+}
+
+// Convert converts amount in currency from to currency to, the result is
+// rounded to the minor unit of the target currency using the given rounding
+// rule.
+func (t *RateTable) Convert(amount Number, from, to string, rule RoundRule) (Number, error) {
+	rate, err := t.Rate(from, to)
+	if err != nil {
+		return Number{}, err
+	}
+	return Convert(amount, rate, to, rule)
+}
+
+// CrossRate derives exchange rate from base to quote currency via another
+// currency, e.g. EURSEK from EURUSD and USDSEK. Each leg is either a direct or
+// an inverse rate from the table, inverse legs are used exactly without
+// intermediate rounding. The exact product of both legs is rounded once to the
+// given exponent using the given rounding rule. ErrUnknownRate is returned if
+// any leg is missing.
+func CrossRate(base, quote string, via string, rates *RateTable, exp int, rule RoundRule) (Number, error) {
+	rates.mu.RLock()
+	defer rates.mu.RUnlock()
+
+	first, ok := rates.exactLeg(base, via)
+	if !ok {
+		return Number{}, ErrUnknownRate
+	}
+	second, ok := rates.exactLeg(via, quote)
+	if !ok {
+		return Number{}, ErrUnknownRate
+	}
+
+	return ratRound(first.Mul(first, second), exp, rule), nil
+}
+
+// exactLeg returns exact direct or inverse rate between two currencies. Caller
+// must hold the read lock.
+func (t *RateTable) exactLeg(base, quote string) (*big.Rat, bool) {
+	if base == quote {
+		return big.NewRat(1, 1), true
+	}
+	if rate, ok := t.rates[currencyPair{base, quote}]; ok {
+		return rate.Rat(), true
+	}
+	if rate, ok := t.rates[currencyPair{quote, base}]; ok {
+		r := rate.Rat()
+		return r.Inv(r), true
+	}
+	return nil, false
+}
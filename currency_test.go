@@ -0,0 +1,147 @@
+package decimal
+
+import (
+	"fmt"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrencyExponent(t *testing.T) {
+	exp, ok := CurrencyExponent("EUR")
+	assert.True(t, ok)
+	assert.Equal(t, -2, exp)
+
+	exp, ok = CurrencyExponent("JPY")
+	assert.True(t, ok)
+	assert.Equal(t, 0, exp)
+
+	exp, ok = CurrencyExponent("KWD")
+	assert.True(t, ok)
+	assert.Equal(t, -3, exp)
+
+	_, ok = CurrencyExponent("XXX")
+	assert.False(t, ok)
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		amount   string
+		rate     string
+		currency string
+		rule     RoundRule
+		expected Number
+	}{
+		{"10.00", "1.0845", "USD", RoundMath, newDecimal.New(1085, -2)},
+		{"10.00", "1.0845", "USD", RoundTruncate, newDecimal.New(1084, -2)},
+		{"10.00", "1.0845", "USD", RoundBankers, newDecimal.New(1084, -2)},
+		{"-10.00", "1.0845", "USD", RoundFloor, newDecimal.New(-1085, -2)},
+		{"100", "161.237", "JPY", RoundMath, newDecimal.New(16124, 0)},
+		{"1.5", "0.3081", "KWD", RoundMath, newDecimal.New(462, -3)},
+	}
+
+	for _, test := range tests {
+		amount, err := FromString(test.amount)
+		assert.NoError(t, err)
+		rate, err := FromString(test.rate)
+		assert.NoError(t, err)
+
+		actual, err := Convert(amount, rate, test.currency, test.rule)
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			test.expected,
+			actual,
+			fmt.Sprintf("%s * %s %s", test.amount, test.rate, test.currency),
+		)
+	}
+
+	_, err := Convert(New(1, 0), New(1, 0), "XXX", RoundMath)
+	assert.ErrorIs(t, err, ErrUnknownCurrency)
+	_, err = Convert(New(1, 0), Zero(), "EUR", RoundMath)
+	assert.ErrorIs(t, err, ErrInvalidRate)
+}
+
+func TestRateTable(t *testing.T) {
+	rates := NewRateTable("USD")
+	assert.NoError(t, rates.Set("EUR", "USD", New(108, -2)))
+	assert.NoError(t, rates.Set("USD", "SEK", New(1050, -2)))
+	assert.ErrorIs(t, rates.Set("USD", "GBP", New(-1, 0)), ErrInvalidRate)
+
+	tests := []struct {
+		base     string
+		quote    string
+		expected Number
+	}{
+		{"EUR", "EUR", newDecimal.New(1, 0)},
+		{"EUR", "USD", newDecimal.New(108, -2)},
+		{"USD", "EUR", newDecimal.New(9259259259, -10)},
+		{"EUR", "SEK", newDecimal.New(113400000000, -10)},
+		{"SEK", "EUR", newDecimal.New(881834215, -10)},
+	}
+
+	for _, test := range tests {
+		actual, err := rates.Rate(test.base, test.quote)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, actual, test.base+test.quote)
+	}
+
+	_, err := rates.Rate("EUR", "GBP")
+	assert.ErrorIs(t, err, ErrUnknownRate)
+	_, err = NewRateTable("").Rate("EUR", "SEK")
+	assert.ErrorIs(t, err, ErrUnknownRate)
+
+	converted, err := rates.Convert(New(10000, -2), "SEK", "EUR", RoundMath)
+	assert.NoError(t, err)
+	assert.Equal(t, newDecimal.New(882, -2), converted)
+}
+
+func TestCrossRate(t *testing.T) {
+	rates := NewRateTable("")
+	assert.NoError(t, rates.Set("EUR", "USD", New(10850, -4)))
+	assert.NoError(t, rates.Set("USD", "SEK", New(104250, -4)))
+	assert.NoError(t, rates.Set("GBP", "USD", New(12650, -4)))
+
+	tests := []struct {
+		base, quote, via string
+		exp              int
+		rule             RoundRule
+		expected         string
+	}{
+		// 1.085 * 10.425 = 11.311125
+		{"EUR", "SEK", "USD", -4, RoundBankers, "11.3111"},
+		{"EUR", "SEK", "USD", -4, RoundCeil, "11.3112"},
+		{"EUR", "SEK", "USD", -6, RoundBankers, "11.311125"},
+		// 1.085 / 1.265 = 0.857707509...
+		{"EUR", "GBP", "USD", -6, RoundBankers, "0.857708"},
+		// 1 / 10.425 / 1.085 = 0.088408535...
+		{"SEK", "EUR", "USD", -8, RoundTruncate, "0.08840853"},
+		{"EUR", "USD", "USD", -4, RoundBankers, "1.085"},
+		{"EUR", "USD", "EUR", -4, RoundBankers, "1.085"},
+	}
+
+	for _, test := range tests {
+		actual, err := CrossRate(test.base, test.quote, test.via, rates, test.exp, test.rule)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, actual.String(), test.base+test.quote)
+	}
+
+	// RateTable derives cross rates the same way
+	rates = NewRateTable("USD")
+	assert.NoError(t, rates.Set("XAU", "USD", New(2350, 0)))
+	assert.NoError(t, rates.Set("XYZ", "USD", New(7, -6)))
+	// 2350 / 0.000007 = 335714285.714285714..., rounding the inverse leg
+	// first would give 335714285.714285815
+	cross, err := CrossRate("XAU", "XYZ", "USD", rates, RateExp, RoundBankers)
+	assert.NoError(t, err)
+	assert.Equal(t, "335714285.7142857143", cross.String())
+	rate, err := rates.Rate("XAU", "XYZ")
+	assert.NoError(t, err)
+	assert.Equal(t, cross, rate)
+
+	_, err = CrossRate("EUR", "JPY", "USD", rates, -4, RoundBankers)
+	assert.ErrorIs(t, err, ErrUnknownRate)
+	_, err = CrossRate("CHF", "SEK", "USD", rates, -4, RoundBankers)
+	assert.ErrorIs(t, err, ErrUnknownRate)
+}
@@ -0,0 +1,108 @@
+package decimal
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Extrapolation is enum type for specifying value of a curve outside of its
+// points range.
+type Extrapolation int
+
+// List of supported extrapolation policies
+const (
+	ExtrapolateClamp  Extrapolation = iota // Use y of the nearest end point
+	ExtrapolateLinear                      // Extend the first and last segments
+	ExtrapolateError                       // Fail with ErrOutOfRange
+)
+
+// Point is a point of a curve.
+type Point struct {
+	X Number
+	Y Number
+}
+
+// Curve is a piecewise-linear function defined by points, e.g. a payout curve
+// or a bonus multiplier table. Curve is immutable and safe for concurrent
+// use.
+type Curve struct {
+	points        []Point
+	extrapolation Extrapolation
+}
+
+// NewCurve creates a curve from points sorted by strictly increasing X. At
+// least one point is required, a single point defines a constant function.
+// Returned error matches ErrInvalidCurve.
+func NewCurve(points []Point, extrapolation Extrapolation) (*Curve, error) {
+	if len(points) == 0 {
+		return nil, fmt.Errorf("%w: no points", ErrInvalidCurve)
+	}
+	for i := 1; i < len(points); i++ {
+		if Cmp(points[i-1].X, points[i].X) >= 0 {
+			return nil, fmt.Errorf("%w: x %s does not follow %s", ErrInvalidCurve, DebugString(points[i].X), DebugString(points[i-1].X))
+		}
+	}
+
+	c := &Curve{points: make([]Point, len(points)), extrapolation: extrapolation}
+	copy(c.points, points)
+	return c, nil
+}
+
+// Eval calculates value of the curve at x using exact linear interpolation
+// between the neighbouring points and rounds it once to the given exponent
+// using the given rounding rule. Values outside of the points range are
+// calculated according to the extrapolation policy, ExtrapolateError policy
+// fails with ErrOutOfRange. Zero value Curve has no points, evaluating it
+// fails with ErrInvalidCurve.
+func (c *Curve) Eval(x Number, exp int, rule RoundRule) (Number, error) {
+	if c == nil || len(c.points) == 0 {
+		return Number{}, fmt.Errorf("%w: no points", ErrInvalidCurve)
+	}
+	first, last := c.points[0], c.points[len(c.points)-1]
+	if Cmp(x, first.X) < 0 || Cmp(x, last.X) > 0 {
+		switch {
+		case c.extrapolation == ExtrapolateError:
+			return Number{}, fmt.Errorf("%w: %s is outside %s-%s", ErrOutOfRange, DebugString(x), DebugString(first.X), DebugString(last.X))
+		case c.extrapolation == ExtrapolateClamp || len(c.points) == 1:
+			if Cmp(x, first.X) < 0 {
+				return Round(first.Y, exp, rule), nil
+			}
+			return Round(last.Y, exp, rule), nil
+		}
+	}
+	if len(c.points) == 1 {
+		return Round(first.Y, exp, rule), nil
+	}
+
+	// segment [i-1, i] containing x, end segments for extrapolation
+	i := sort.Search(len(c.points), func(i int) bool {
+		return Cmp(c.points[i].X, x) >= 0
+	})
+	if i < len(c.points) && Cmp(c.points[i].X, x) == 0 {
+		return Round(c.points[i].Y, exp, rule), nil
+	}
+	if i == 0 {
+		i = 1
+	} else if i == len(c.points) {
+		i--
+	}
+	return interpolate(c.points[i-1], c.points[i], x, exp, rule), nil
+}
+
+// Points returns a copy of the curve points, nil for zero value Curve.
+func (c *Curve) Points() []Point {
+	if c == nil || len(c.points) == 0 {
+		return nil
+	}
+	points := make([]Point, len(c.points))
+	copy(points, c.points)
+	return points
+}
+
+// interpolate calculates y at x on the line through points a and b.
+func interpolate(a, b Point, x Number, exp int, rule RoundRule) Number {
+	// y = (ay * (bx - ax) + (x - ax) * (by - ay)) / (bx - ax)
+	dx := b.X.Sub(a.X)
+	num := a.Y.Mul(dx).Add(x.Sub(a.X).Mul(b.Y.Sub(a.Y)))
+	return divRound(num, dx, exp, rule)
+}
@@ -0,0 +1,102 @@
+package decimal
+
+import (
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func points(xy ...string) []Point {
+	ps := make([]Point, 0, len(xy)/2)
+	for i := 0; i < len(xy); i += 2 {
+		ps = append(ps, Point{X: newDecimal.RequireFromString(xy[i]), Y: newDecimal.RequireFromString(xy[i+1])})
+	}
+	return ps
+}
+
+func TestNewCurve(t *testing.T) {
+	_, err := NewCurve(nil, ExtrapolateClamp)
+	assert.ErrorIs(t, err, ErrInvalidCurve)
+	_, err = NewCurve(points("1", "1", "1", "2"), ExtrapolateClamp)
+	assert.ErrorIs(t, err, ErrInvalidCurve)
+	_, err = NewCurve(points("2", "1", "1", "2"), ExtrapolateClamp)
+	assert.ErrorIs(t, err, ErrInvalidCurve)
+
+	ps := points("0", "1", "10", "2")
+	c, err := NewCurve(ps, ExtrapolateClamp)
+	assert.NoError(t, err)
+	ps[0].Y = New(100, 0)
+	assert.Equal(t, points("0", "1", "10", "2"), c.Points())
+}
+
+func TestCurveEval(t *testing.T) {
+	// multiplier grows 1.00 -> 1.50 on 2-5 legs, 1.50 -> 3.00 on 5-10 legs
+	ps := points("2", "1", "5", "1.5", "10", "3")
+
+	tests := []struct {
+		x             string
+		extrapolation Extrapolation
+		exp           int
+		rule          RoundRule
+		expected      Number
+	}{
+		{"2", ExtrapolateClamp, -2, RoundTruncate, New(100, -2)},
+		{"3", ExtrapolateClamp, -2, RoundTruncate, New(116, -2)},
+		{"3", ExtrapolateClamp, -2, RoundMath, New(117, -2)},
+		{"3", ExtrapolateClamp, -6, RoundTruncate, New(1166666, -6)},
+		{"4", ExtrapolateClamp, -4, RoundBankers, New(13333, -4)},
+		{"5", ExtrapolateClamp, -2, RoundTruncate, New(150, -2)},
+		{"7.5", ExtrapolateClamp, -2, RoundTruncate, New(225, -2)},
+		{"10", ExtrapolateClamp, -2, RoundTruncate, New(300, -2)},
+		{"1", ExtrapolateClamp, -2, RoundTruncate, New(100, -2)},
+		{"12", ExtrapolateClamp, -2, RoundTruncate, New(300, -2)},
+		{"1", ExtrapolateLinear, -3, RoundTruncate, New(833, -3)},
+		{"1", ExtrapolateLinear, -3, RoundFloor, New(833, -3)},
+		{"1", ExtrapolateLinear, -3, RoundCeil, New(834, -3)},
+		{"12", ExtrapolateLinear, -2, RoundTruncate, New(360, -2)},
+		{"-10", ExtrapolateLinear, -2, RoundTruncate, New(-100, -2)},
+		{"7.5", ExtrapolateError, -2, RoundTruncate, New(225, -2)},
+	}
+
+	for _, test := range tests {
+		c, err := NewCurve(ps, test.extrapolation)
+		assert.NoError(t, err)
+		actual, err := c.Eval(newDecimal.RequireFromString(test.x), test.exp, test.rule)
+		assert.NoError(t, err, test.x)
+		assert.Equal(t, test.expected, actual, test.x)
+	}
+
+	c, err := NewCurve(ps, ExtrapolateError)
+	assert.NoError(t, err)
+	_, err = c.Eval(New(1, 0), -2, RoundMath)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+	_, err = c.Eval(New(11, 0), -2, RoundMath)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+	assert.EqualError(t, err, `decimal: value out of range: dec(coef=11, exp=0, "11") is outside dec(coef=2, exp=0, "2")-dec(coef=10, exp=0, "10")`)
+}
+
+func TestCurveSinglePoint(t *testing.T) {
+	for _, e := range []Extrapolation{ExtrapolateClamp, ExtrapolateLinear} {
+		c, err := NewCurve(points("5", "1.25"), e)
+		assert.NoError(t, err)
+		for _, x := range []Number{New(0, 0), New(5, 0), New(100, 0)} {
+			y, err := c.Eval(x, -1, RoundBankers)
+			assert.NoError(t, err)
+			assert.Equal(t, New(12, -1), y)
+		}
+	}
+
+	c, err := NewCurve(points("5", "1.25"), ExtrapolateError)
+	assert.NoError(t, err)
+	_, err = c.Eval(New(6, 0), -2, RoundMath)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+}
+
+func TestCurveZeroValue(t *testing.T) {
+	for _, c := range []*Curve{nil, new(Curve), {}} {
+		_, err := c.Eval(One, -2, RoundMath)
+		assert.ErrorIs(t, err, ErrInvalidCurve)
+		assert.Nil(t, c.Points())
+	}
+}
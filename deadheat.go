@@ -0,0 +1,91 @@
+package decimal
+
+import (
+	"fmt"
+)
+
+// MaxRule4Deduction is the largest total Rule 4 deduction in pence in the
+// pound, deductions for several withdrawn runners are capped to it.
+const MaxRule4Deduction = 90
+
+// DeadHeatStake returns part of the stake settled at full odds when the
+// selection dead-heats, i.e. tied runners share places paid places, e.g. two
+// runners tied for the last paid place share one place and half of the stake
+// is settled as a winner. The reduced stake stake * places / tied is rounded
+// once to the given exponent using the given rounding rule, the rest of the
+// stake is lost. The stake is not reduced if places is not below tied. It
+// panics if tied or places is less than 1.
+func DeadHeatStake(stake Number, tied, places int, exp int, rule RoundRule) Number {
+	tied, places = deadHeat(tied, places)
+	return divRound(stake.Mul(New(int64(places), 0)), New(int64(tied), 0), exp, rule)
+}
+
+// DeadHeatPayout calculates return of a winning bet with dead-heat reduction,
+// stake * places / tied * odds. The reduced stake is not rounded, the exact
+// return is rounded once according to the policy and limited to the policy
+// cap. See DeadHeatStake for the meaning of tied and places.
+func DeadHeatPayout(stake, odds Number, tied, places int, policy PayoutPolicy) Number {
+	return DeadHeatRule4Payout(stake, odds, tied, places, 0, policy)
+}
+
+// Rule4Payout calculates return of a winning bet with Rule 4 deduction of
+// the given pence in the pound applied to the winnings, i.e. the stake is
+// returned in full and the winnings stake * (odds - 1) are reduced by
+// deduction / 100. The exact return is rounded once according to the policy
+// and limited to the policy cap. It panics if deduction is not between 0 and
+// 100, use Rule4Deduction to combine deductions of several withdrawals.
+func Rule4Payout(stake, odds Number, deduction int, policy PayoutPolicy) Number {
+	return DeadHeatRule4Payout(stake, odds, 1, 1, deduction, policy)
+}
+
+// DeadHeatRule4Payout calculates return of a winning bet having both
+// dead-heat reduction and Rule 4 deduction. Dead-heat reduces the stake
+// first, the deduction is applied to the winnings of the reduced stake:
+//
+//	s = stake * places / tied
+//	return = s + s * (odds - 1) * (100 - deduction) / 100
+//
+// All steps are exact, the return is rounded once according to the policy
+// and limited to the policy cap. It panics if tied or places is less than 1
+// or deduction is not between 0 and 100.
+func DeadHeatRule4Payout(stake, odds Number, tied, places, deduction int, policy PayoutPolicy) Number {
+	tied, places = deadHeat(tied, places)
+	if deduction < 0 || deduction > 100 {
+		panic(fmt.Sprintf("decimal: Rule 4 deduction of %dp is not between 0 and 100", deduction))
+	}
+
+	// stake * places * (100 + (odds - 1) * (100 - deduction)) / (tied * 100)
+	winnings := odds.Sub(One).Mul(New(int64(100-deduction), 0))
+	num := stake.Mul(New(int64(places), 0)).Mul(winnings.Add(Hundred))
+	ret := divRound(num, New(int64(tied)*100, 0), policy.Exp, policy.Rule)
+	return policy.limit(ret)
+}
+
+// Rule4Deduction returns total Rule 4 deduction of several withdrawn runners
+// in pence in the pound, the sum of deductions capped to MaxRule4Deduction.
+// It panics if any deduction is negative.
+func Rule4Deduction(deductions ...int) int {
+	total := 0
+	for _, d := range deductions {
+		if d < 0 {
+			panic(fmt.Sprintf("decimal: negative Rule 4 deduction of %dp", d))
+		}
+		total += d
+	}
+	if total > MaxRule4Deduction {
+		return MaxRule4Deduction
+	}
+	return total
+}
+
+// deadHeat validates tied runners and shared places, places are limited to
+// the number of tied runners.
+func deadHeat(tied, places int) (int, int) {
+	if tied < 1 || places < 1 {
+		panic(fmt.Sprintf("decimal: invalid dead heat of %d runners sharing %d places", tied, places))
+	}
+	if places > tied {
+		places = tied
+	}
+	return tied, places
+}
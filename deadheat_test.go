@@ -0,0 +1,79 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeadHeatStake(t *testing.T) {
+	tests := []struct {
+		tied, places int
+		expected     string
+	}{
+		{2, 1, "5"},
+		{3, 1, "3.33"},
+		{3, 2, "6.67"},
+		{2, 3, "10"},
+		{1, 1, "10"},
+	}
+
+	for _, test := range tests {
+		actual := DeadHeatStake(New(10, 0), test.tied, test.places, -2, RoundBankers)
+		assert.Equal(t, test.expected, actual.String(), "%d tied for %d places", test.tied, test.places)
+	}
+
+	assert.Panics(t, func() { DeadHeatStake(One, 0, 1, -2, RoundBankers) })
+	assert.Panics(t, func() { DeadHeatStake(One, 2, 0, -2, RoundBankers) })
+}
+
+func TestDeadHeatPayout(t *testing.T) {
+	policy := PayoutPolicy{Exp: -2, Rule: RoundTruncate}
+
+	// reduced stake is not rounded, 3.33 * 5 would be 16.65
+	assert.Equal(t, "16.66", DeadHeatPayout(New(10, 0), New(5, 0), 3, 1, policy).String())
+	assert.Equal(t, "25", DeadHeatPayout(New(10, 0), New(5, 0), 2, 1, policy).String())
+	assert.Equal(t, "50", DeadHeatPayout(New(10, 0), New(5, 0), 2, 2, policy).String())
+
+	policy.Cap = New(15, 0)
+	assert.Equal(t, "15", DeadHeatPayout(New(10, 0), New(5, 0), 2, 1, policy).String())
+}
+
+func TestRule4Payout(t *testing.T) {
+	policy := PayoutPolicy{Exp: -2, Rule: RoundBankers}
+	tests := []struct {
+		stake, odds Number
+		deduction   int
+		expected    string
+	}{
+		{New(10, 0), New(5, 0), 0, "50"},
+		{New(10, 0), New(5, 0), 25, "40"},
+		{New(10, 0), New(33, -1), 15, "29.55"},
+		{New(333, -2), New(27, -1), 10, "8.42"},
+		{New(10, 0), New(5, 0), 100, "10"},
+	}
+
+	for _, test := range tests {
+		actual := Rule4Payout(test.stake, test.odds, test.deduction, policy)
+		assert.Equal(t, test.expected, actual.String(), "%s @ %s less %dp", test.stake, test.odds, test.deduction)
+	}
+
+	assert.Panics(t, func() { Rule4Payout(One, Two, -5, policy) })
+	assert.Panics(t, func() { Rule4Payout(One, Two, 105, policy) })
+}
+
+func TestDeadHeatRule4Payout(t *testing.T) {
+	policy := PayoutPolicy{Exp: -2, Rule: RoundBankers}
+
+	// 5 + 5 * 4 * 0.8
+	assert.Equal(t, "21", DeadHeatRule4Payout(New(10, 0), New(5, 0), 2, 1, 20, policy).String())
+	// 10/3 + 10/3 * 2.5 * 0.9 = 10.8333...
+	assert.Equal(t, "10.83", DeadHeatRule4Payout(New(10, 0), New(35, -1), 3, 1, 10, policy).String())
+}
+
+func TestRule4Deduction(t *testing.T) {
+	assert.Equal(t, 0, Rule4Deduction())
+	assert.Equal(t, 35, Rule4Deduction(10, 25))
+	assert.Equal(t, 90, Rule4Deduction(50, 45))
+	assert.Panics(t, func() { Rule4Deduction(10, -5) })
+}
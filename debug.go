@@ -0,0 +1,24 @@
+package decimal
+
+import (
+	"fmt"
+)
+
+// debugMaxExp is the largest exponent magnitude of numbers that DebugString
+// formats in decimal notation as well.
+const debugMaxExp = 1000
+
+// DebugString returns representation of n exposing its internal coefficient
+// and exponent, e.g. `dec(coef=1234, exp=-2, "12.34")`. Uninitialized
+// Number{} is formatted with nil coefficient. Decimal notation is omitted for
+// numbers with exponents beyond ±1000, so formatting malformed values stays
+// cheap.
+func DebugString(n Number) string {
+	if n == (Number{}) {
+		return `dec(coef=nil, exp=0, "0")`
+	}
+	if exp := n.Exponent(); exp > debugMaxExp || exp < -debugMaxExp {
+		return fmt.Sprintf("dec(coef=%s, exp=%d)", n.Coefficient(), exp)
+	}
+	return fmt.Sprintf("dec(coef=%s, exp=%d, %q)", n.Coefficient(), n.Exponent(), String(n))
+}
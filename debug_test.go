@@ -0,0 +1,36 @@
+package decimal
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebugString(t *testing.T) {
+	tests := []struct {
+		n        Number
+		expected string
+	}{
+		{New(1234, -2), `dec(coef=1234, exp=-2, "12.34")`},
+		{New(12340, -3), `dec(coef=12340, exp=-3, "12.34")`},
+		{New(-5, 2), `dec(coef=-5, exp=2, "-500")`},
+		{New(0, -2), `dec(coef=0, exp=-2, "0")`},
+		{Number{}, `dec(coef=nil, exp=0, "0")`},
+		{New(1, 1000), `dec(coef=1, exp=1000, "1` + zeros(1000) + `")`},
+		{New(1, math.MaxInt32), `dec(coef=1, exp=2147483647)`},
+		{New(-7, math.MinInt32), `dec(coef=-7, exp=-2147483648)`},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, DebugString(test.n))
+	}
+}
+
+func zeros(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = '0'
+	}
+	return string(b)
+}
@@ -9,6 +9,10 @@ import (
 
 type Number = newDecimal.Decimal
 
+// NullNumber is a nullable decimal number with compatibility for scanning null
+// values from the database.
+type NullNumber = newDecimal.NullDecimal
+
 // RoundRule is enum type for specifying rounding algorithm when decimal number
 // is scaled with loss of precision. List of supported rounding rules are listed
 // in `Round*` consts.
@@ -32,6 +36,14 @@ func Zero() Number {
 	return newDecimal.New(0, 0)
 }
 
+// Copy returns a deep copy of a decimal number that does not share the
+// coefficient storage with n. Package functions never alias big.Int values
+// owned by the caller, Copy is meant for code that accesses the coefficient
+// through other means.
+func Copy(n Number) Number {
+	return newDecimal.NewFromBigInt(n.Coefficient(), n.Exponent())
+}
+
 // New creates a new decimal number having value of val*10^exp.
 func New(val int64, exp int) Number {
 	return newDecimal.New(val, int32(exp))
@@ -44,19 +56,39 @@ func FromInt(val int) Number {
 }
 
 // FromString creates a new instance of decimal number by parsing given string.
+// Returned error is a *ParseError.
 func FromString(str string) (Number, error) {
-	return newDecimal.NewFromString(str)
+	var scratch parseScratch
+	if n, ok := parsePlain([]byte(str), &scratch); ok {
+		return n, nil
+	}
+
+	n, err := newDecimal.NewFromString(str)
+	if err != nil {
+		return Number{}, &ParseError{Input: str, Err: err}
+	}
+	return n, nil
 }
 
 // Round scales decimal value to an integer value with given exponent. On
 // exponent scale-down decimal value precision is preserved, on exponent
-// scale-up rounding with the given rounding rule is performed.
+// scale-up rounding with the given rounding rule is performed. Uninitialized
+// Number{} is treated as zero.
 func Round(value newDecimal.Decimal, exp int, rule RoundRule) newDecimal.Decimal {
+	value = ensureInitialized(value)
+
 	// scale-down case
 	if exp <= int(value.Exponent()) {
 		return Rescale(value, int32(exp))
 	}
 
+	// fast path for coefficients fitting int64
+	if diff := exp - int(value.Exponent()); diff < len(pow10Int64) {
+		if c := value.Coefficient(); c.IsInt64() {
+			return newDecimal.New(roundInt64(c.Int64(), pow10Int64[diff], rule), int32(exp))
+		}
+	}
+
 	switch rule {
 	case RoundBankers:
 		return Rescale(value.RoundBank(-1*int32(exp)), int32(exp))
@@ -71,10 +103,43 @@ func Round(value newDecimal.Decimal, exp int, rule RoundRule) newDecimal.Decimal
 	}
 }
 
-// MulInt calculates d * n value.
+// MulInt calculates d * n value. Uninitialized Number{} is treated as zero.
 func MulInt(value newDecimal.Decimal, n int) newDecimal.Decimal {
 	d := newDecimal.NewFromInt(int64(n))
-	return value.Mul(d)
+	return ensureInitialized(value).Mul(d)
+}
+
+// Abs returns absolute value of a decimal number. The exponent of value is
+// preserved exactly, e.g. Abs(-1.50) is 1.50. Uninitialized Number{} is
+// treated as zero.
+func Abs(value newDecimal.Decimal) newDecimal.Decimal {
+	coef := value.Coefficient()
+	return newDecimal.NewFromBigInt(coef.Abs(coef), value.Exponent())
+}
+
+// Negate returns -value. The exponent of value is preserved exactly, e.g.
+// Negate(1.50) is -1.50. Uninitialized Number{} is treated as zero.
+func Negate(value newDecimal.Decimal) newDecimal.Decimal {
+	coef := value.Coefficient()
+	return newDecimal.NewFromBigInt(coef.Neg(coef), value.Exponent())
+}
+
+// Signum returns -1, 0 or 1 having exponent 0 for negative, zero and positive
+// numbers respectively. Uninitialized Number{} is treated as zero.
+func Signum(value newDecimal.Decimal) newDecimal.Decimal {
+	return newDecimal.New(int64(value.Sign()), 0)
+}
+
+// IsNegative reports if a decimal number is less than zero. Uninitialized
+// Number{} is treated as zero.
+func IsNegative(value newDecimal.Decimal) bool {
+	return value.Sign() < 0
+}
+
+// IsPositive reports if a decimal number is greater than zero. Uninitialized
+// Number{} is treated as zero.
+func IsPositive(value newDecimal.Decimal) bool {
+	return value.Sign() > 0
 }
 
 // ScaledVal scales decimal number to a given exponent and returns
@@ -82,28 +147,260 @@ func MulInt(value newDecimal.Decimal, n int) newDecimal.Decimal {
 // number exponent this function will lose truncated digits.
 //
 // Example: number "12.99" with call ScaledVal(-4) would return 129900, with
-// call ScaledVal(0) would return 12.
+// call ScaledVal(0) would return 12. Uninitialized Number{} is treated as zero.
 func ScaledVal(d newDecimal.Decimal, exp int) int64 {
 	return Rescale(d, int32(exp)).CoefficientInt64()
 }
 
 // NewFromRat returns a new Decimal from a big.Rat. The numerator and
-// denominator are divided and rounded to the given exponent.
+// denominator are divided and rounded to the given exponent. The result does
+// not reference r.
 func NewFromRat(r *big.Rat, e int) newDecimal.Decimal {
 	return Round(newDecimal.NewFromBigInt(r.Num(), 0).Div(newDecimal.NewFromBigInt(r.Denom(), 0)), e, RoundTruncate)
 }
 
-// Rescale copied from `shopspring/decimal`
+// NewFromRatExact returns a new Decimal from a big.Rat exactly. The second
+// return value reports if r is representable as a finite decimal, i.e. its
+// reduced denominator has no prime factors other than 2 and 5. Exact results
+// have the smallest exponent needed and n.Rat() returns a value equal to r.
+// Otherwise the quotient is truncated to DivisionPrecision decimal places.
+// The result does not reference r.
+func NewFromRatExact(r *big.Rat) (Number, bool) {
+	den := new(big.Int).Set(r.Denom())
+	twos := den.TrailingZeroBits()
+	den.Rsh(den, twos)
+
+	fives := uint(0)
+	five := big.NewInt(5)
+	q, m := new(big.Int), new(big.Int)
+	for {
+		q.QuoRem(den, five, m)
+		if m.Sign() != 0 {
+			break
+		}
+		den, q = q, den
+		fives++
+	}
+
+	if den.Cmp(big.NewInt(1)) != 0 {
+		return ratRound(r, -int(newDecimal.DivisionPrecision), RoundTruncate), false
+	}
+
+	// num/den = num * 10^k/den * 10^-k, where 10^k/den is an integer
+	k := twos
+	if fives > k {
+		k = fives
+	}
+	coef := new(big.Int).Mul(r.Num(), pow10(int64(k)))
+	coef.Quo(coef, r.Denom())
+	return newDecimal.NewFromBigInt(coef, -int32(k)), true
+}
+
+// divRound calculates x / y and rounds the quotient to an integer value with
+// the given exponent using the given rounding rule. Unlike Div, rounding is
+// applied to the exact quotient so the result never depends on a global
+// division precision. It panics if y is zero.
+func divRound(x, y Number, exp int, rule RoundRule) Number {
+	// x/y * 10^-exp = (cx/cy) * 10^(ex-ey-exp)
+	shift := int64(x.Exponent()) - int64(y.Exponent()) - int64(exp)
+	num := x.Coefficient()
+	den := y.Coefficient()
+	if den.Sign() == 0 {
+		panic("decimal division by 0: " + DebugString(x) + " / " + DebugString(y))
+	}
+
+	scale := pow10(abs64(shift))
+	if shift > 0 {
+		num.Mul(num, scale)
+	} else {
+		den.Mul(den, scale)
+	}
+
+	return newDecimal.NewFromBigInt(quoRound(num, den, rule), int32(exp))
+}
+
+// ratRound rounds exact rational number r to an integer value with the given
+// exponent using the given rounding rule.
+func ratRound(r *big.Rat, exp int, rule RoundRule) Number {
+	num := new(big.Int).Set(r.Num())
+	den := new(big.Int).Set(r.Denom())
+	if exp < 0 {
+		num.Mul(num, pow10(int64(-exp)))
+	} else {
+		den.Mul(den, pow10(int64(exp)))
+	}
+	return newDecimal.NewFromBigInt(quoRound(num, den, rule), int32(exp))
+}
+
+// quoRound returns num / den rounded to an integer using the given rounding
+// rule. Arguments are not modified.
+func quoRound(num, den *big.Int, rule RoundRule) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	// sign of the exact quotient, q is truncated towards zero
+	sign := int64(num.Sign() * den.Sign())
+
+	switch rule {
+	case RoundFloor:
+		if sign < 0 {
+			q.Sub(q, big.NewInt(1))
+		}
+	case RoundCeil:
+		if sign > 0 {
+			q.Add(q, big.NewInt(1))
+		}
+	case RoundMath, RoundBankers:
+		// compare 2*|r| with |den| to find which side of the tie we are on
+		half := new(big.Int).Abs(r)
+		half.Lsh(half, 1)
+		c := half.Cmp(new(big.Int).Abs(den))
+		if c > 0 || (c == 0 && (rule == RoundMath || q.Bit(0) == 1)) {
+			q.Add(q, big.NewInt(sign))
+		}
+	}
+
+	return q
+}
+
+// pow10Int64 holds all powers of ten that fit into int64.
+var pow10Int64 = [...]int64{
+	1,
+	10,
+	100,
+	1000,
+	10000,
+	100000,
+	1000000,
+	10000000,
+	100000000,
+	1000000000,
+	10000000000,
+	100000000000,
+	1000000000000,
+	10000000000000,
+	100000000000000,
+	1000000000000000,
+	10000000000000000,
+	100000000000000000,
+	1000000000000000000,
+}
+
+// pow10BigLen is the number of cached big.Int powers of ten.
+const pow10BigLen = 400
+
+// pow10Big holds cached big.Int powers of ten, values are shared and must
+// never be modified.
+var pow10Big = func() (pows [pow10BigLen]*big.Int) {
+	pows[0] = big.NewInt(1)
+	for i := 1; i < len(pows); i++ {
+		pows[i] = new(big.Int).Mul(pows[i-1], big.NewInt(10))
+	}
+	return pows
+}()
+
+// pow10 returns 10^n, n must not be negative. Returned value might be shared
+// and must not be modified.
+func pow10(n int64) *big.Int {
+	if n < pow10BigLen {
+		return pow10Big[n]
+	}
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(n), nil)
+}
+
+// roundInt64 returns c / p rounded to an integer using the given rounding
+// rule, p must be positive.
+func roundInt64(c, p int64, rule RoundRule) int64 {
+	q, r := c/p, c%p
+	if r == 0 {
+		return q
+	}
+
+	// sign of the exact quotient, q is truncated towards zero
+	sign := int64(1)
+	if c < 0 {
+		sign = -1
+	}
+
+	switch rule {
+	case RoundFloor:
+		if sign < 0 {
+			q--
+		}
+	case RoundCeil:
+		if sign > 0 {
+			q++
+		}
+	case RoundMath, RoundBankers:
+		// |r| < p <= 10^18, doubling it can not overflow
+		half := 2 * abs64(r)
+		if half > p || (half == p && (rule == RoundMath || q%2 != 0)) {
+			q += sign
+		}
+	}
+
+	return q
+}
+
+// rescaleInt64 returns c * 10^-shift, truncating the remainder. The second
+// return value is false if the result does not fit into int64 or the shift is
+// out of the precomputed powers of ten range.
+func rescaleInt64(c int64, shift int64) (int64, bool) {
+	if abs64(shift) >= int64(len(pow10Int64)) {
+		return 0, false
+	}
+	if shift >= 0 {
+		return c / pow10Int64[shift], true
+	}
+
+	p := pow10Int64[-shift]
+	if c > math.MaxInt64/p || c < math.MinInt64/p {
+		return 0, false
+	}
+	return c * p, true
+}
+
+// ensureInitialized replaces uninitialized Number{} having nil coefficient
+// with an equivalent zero value. Results of the package functions never hold
+// a nil coefficient, so they can be safely compared with == or reflection.
+func ensureInitialized(n Number) Number {
+	if n == (Number{}) {
+		return Zero()
+	}
+	return n
+}
+
+func abs64(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// Rescale copied from `shopspring/decimal`. Uninitialized Number{} is treated
+// as zero. If the exponent changes, the result has its own coefficient
+// storage.
 func Rescale(d newDecimal.Decimal, exp int32) newDecimal.Decimal {
+	d = ensureInitialized(d)
 	if d.Exponent() == exp {
 		return d
 	}
 
+	value := d.Coefficient()
+
+	// fast path for coefficients fitting int64
+	if value.IsInt64() {
+		if v, ok := rescaleInt64(value.Int64(), int64(exp)-int64(d.Exponent())); ok {
+			return newDecimal.New(v, exp)
+		}
+	}
+
 	// NOTE(vadim): must convert exps to float64 before - to prevent overflow
 	diff := math.Abs(float64(exp) - float64(d.Exponent()))
-	value := new(big.Int).Set(d.Coefficient())
 
-	expScale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(diff)), nil)
+	expScale := pow10(int64(diff))
 	if exp > d.Exponent() {
 		value = value.Quo(value, expScale)
 	} else if exp < d.Exponent() {
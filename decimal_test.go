@@ -3,7 +3,9 @@ package decimal
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/big"
+	"reflect"
 	"testing"
 
 	newDecimal "github.com/shopspring/decimal"
@@ -372,6 +374,36 @@ func TestNewFromRat(t *testing.T) {
 	}
 }
 
+func TestNewFromRatExact(t *testing.T) {
+	tests := []struct {
+		rat      *big.Rat
+		expected Number
+		exact    bool
+	}{
+		{big.NewRat(0, 1), newDecimal.New(0, 0), true},
+		{big.NewRat(5, 1), newDecimal.New(5, 0), true},
+		{big.NewRat(-1234, 100), newDecimal.New(-1234, -2), true},
+		{big.NewRat(1, 2), newDecimal.New(5, -1), true},
+		{big.NewRat(1, 8), newDecimal.New(125, -3), true},
+		{big.NewRat(-3, 40), newDecimal.New(-75, -3), true},
+		{big.NewRat(1, 625), newDecimal.New(16, -4), true},
+		{new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Lsh(big.NewInt(1), 70)),
+			newDecimal.NewFromBigInt(new(big.Int).Exp(big.NewInt(5), big.NewInt(70), nil), -70), true},
+		{big.NewRat(1, 3), newDecimal.New(3333333333333333, -16), false},
+		{big.NewRat(-2, 3), newDecimal.New(-6666666666666666, -16), false},
+		{big.NewRat(1, 30), newDecimal.New(333333333333333, -16), false},
+	}
+
+	for _, test := range tests {
+		actual, exact := NewFromRatExact(test.rat)
+		assert.Equal(t, test.expected, actual, test.rat.String())
+		assert.Equal(t, test.exact, exact, test.rat.String())
+		if exact {
+			assert.Equal(t, 0, actual.Rat().Cmp(test.rat), test.rat.String())
+		}
+	}
+}
+
 func TestNumberMulInt(t *testing.T) {
 	tests := []struct {
 		x        Number
@@ -408,6 +440,56 @@ func TestNumberMulInt(t *testing.T) {
 	}
 }
 
+func TestNumberUnary(t *testing.T) {
+	tests := []struct {
+		x      Number
+		abs    Number
+		negate Number
+		signum Number
+	}{{
+		x:      newDecimal.New(0, 0),
+		abs:    newDecimal.New(0, 0),
+		negate: newDecimal.New(0, 0),
+		signum: newDecimal.New(0, 0),
+	}, {
+		// Assert exponent is not normalized
+		x:      newDecimal.New(-150, -2),
+		abs:    newDecimal.New(150, -2),
+		negate: newDecimal.New(150, -2),
+		signum: newDecimal.New(-1, 0),
+	}, {
+		// Assert exponent is not normalized
+		x:      newDecimal.New(150, -2),
+		abs:    newDecimal.New(150, -2),
+		negate: newDecimal.New(-150, -2),
+		signum: newDecimal.New(1, 0),
+	}, {
+		// Assert exponent is not normalized
+		x:      newDecimal.New(0, -3),
+		abs:    newDecimal.New(0, -3),
+		negate: newDecimal.New(0, -3),
+		signum: newDecimal.New(0, 0),
+	}, {
+		x:      newDecimal.New(-7, 2),
+		abs:    newDecimal.New(7, 2),
+		negate: newDecimal.New(7, 2),
+		signum: newDecimal.New(-1, 0),
+	}, {
+		x:      Number{},
+		abs:    newDecimal.New(0, 0),
+		negate: newDecimal.New(0, 0),
+		signum: newDecimal.New(0, 0),
+	}}
+
+	for _, test := range tests {
+		assert.Equal(t, test.abs, Abs(test.x), "Abs(%s)", test.x)
+		assert.Equal(t, test.negate, Negate(test.x), "Negate(%s)", test.x)
+		assert.Equal(t, test.signum, Signum(test.x), "Signum(%s)", test.x)
+		assert.Equal(t, test.x.Sign() < 0, IsNegative(test.x), test.x.String())
+		assert.Equal(t, test.x.Sign() > 0, IsPositive(test.x), test.x.String())
+	}
+}
+
 func TestNumberIsZero(t *testing.T) {
 	assert.True(t, newDecimal.Zero.IsZero())
 	assert.True(t, newDecimal.New(0, -1).IsZero())
@@ -416,6 +498,59 @@ func TestNumberIsZero(t *testing.T) {
 	assert.False(t, newDecimal.New(1, 0).IsZero())
 }
 
+func TestZeroValue(t *testing.T) {
+	var z Number
+
+	for _, rule := range []RoundRule{RoundTruncate, RoundFloor, RoundCeil, RoundMath, RoundBankers} {
+		assert.Equal(t, Zero(), Round(z, 0, rule))
+		assert.Equal(t, New(0, 2), Round(z, 2, rule))
+		assert.Equal(t, New(0, -2), Round(z, -2, rule))
+	}
+	assert.Equal(t, Zero(), Rescale(z, 0))
+	assert.Equal(t, New(0, -3), Rescale(z, -3))
+	assert.Equal(t, int64(0), ScaledVal(z, 0))
+	assert.Equal(t, int64(0), ScaledVal(z, -4))
+	assert.Equal(t, Zero(), MulInt(z, 7))
+
+	assert.Equal(t, 0, Cmp(z, Zero()))
+	assert.Equal(t, 0, Cmp(New(0, -5), z))
+	assert.Equal(t, -1, Cmp(z, New(1, -2)))
+	assert.Equal(t, 1, Cmp(z, New(-1, 3)))
+	assert.Equal(t, 0, CmpInt64(z, 0))
+	assert.Equal(t, -1, CmpInt64(z, 1))
+}
+
+// coefficientPtr returns address of the coefficient storage of a number.
+func coefficientPtr(n Number) uintptr {
+	return reflect.ValueOf(n).Field(0).Pointer()
+}
+
+func TestCopy(t *testing.T) {
+	n := newDecimal.RequireFromString("-123456789012345678901234567890.123")
+	c := Copy(n)
+
+	assert.Equal(t, n, c)
+	assert.NotEqual(t, coefficientPtr(n), coefficientPtr(c))
+	assert.Equal(t, Zero(), Copy(Number{}))
+}
+
+func TestNoAliasing(t *testing.T) {
+	coef, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	n := newDecimal.NewFromBigInt(coef, -3)
+
+	r := Rescale(n, -5)
+	assert.NotEqual(t, coefficientPtr(n), coefficientPtr(r))
+	r = Round(n, 0, RoundBankers)
+	assert.NotEqual(t, coefficientPtr(n), coefficientPtr(r))
+
+	rat := new(big.Rat).SetFrac(coef, big.NewInt(1))
+	r = NewFromRat(rat, 0)
+	assert.NotEqual(t, reflect.ValueOf(rat.Num()).Pointer(), coefficientPtr(r))
+	r.Coefficient().SetInt64(1)
+	assert.Equal(t, "123456789012345678901234567890", rat.Num().String())
+	assert.Equal(t, "123456789012345678901234567890", r.String())
+}
+
 func TestNumberRound(t *testing.T) {
 	tests := []struct {
 		rule   RoundRule
@@ -493,6 +628,155 @@ func TestNumberRound(t *testing.T) {
 	}
 }
 
+func TestNumberRoundFastPath(t *testing.T) {
+	// reference implementation without int64 fast path
+	reference := func(value Number, exp int, rule RoundRule) Number {
+		switch rule {
+		case RoundBankers:
+			value = value.RoundBank(-1 * int32(exp))
+		case RoundMath:
+			value = value.Round(-1 * int32(exp))
+		case RoundFloor:
+			value = value.RoundFloor(-1 * int32(exp))
+		case RoundCeil:
+			value = value.RoundCeil(-1 * int32(exp))
+		}
+		return newDecimal.NewFromBigInt(value.Shift(-int32(exp)).BigInt(), int32(exp))
+	}
+
+	coefficients := []string{
+		"0", "1", "5", "15", "25", "149", "150", "151", "9999",
+		"123456789012345678", "999999999999999999", "9223372036854775807",
+		"9223372036854775808", "123456789012345678901234567890",
+	}
+	rules := []RoundRule{RoundTruncate, RoundFloor, RoundCeil, RoundMath, RoundBankers}
+
+	for _, coef := range coefficients {
+		for _, sign := range []string{"", "-"} {
+			for _, valueExp := range []int32{-20, -4, -1, 0} {
+				c, ok := new(big.Int).SetString(sign+coef, 10)
+				assert.True(t, ok)
+				value := newDecimal.NewFromBigInt(c, valueExp)
+
+				for _, rule := range rules {
+					for exp := int(valueExp) + 1; exp <= int(valueExp)+20; exp++ {
+						assert.True(
+							t,
+							reference(value, exp, rule).Equal(Round(value, exp, rule)),
+							fmt.Sprintf("%s round(%d, %d)", value, exp, rule),
+						)
+						assert.Equal(t, int32(exp), Round(value, exp, rule).Exponent())
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestRescale(t *testing.T) {
+	tests := []struct {
+		n        Number
+		exp      int32
+		expected Number
+	}{
+		{newDecimal.New(1234, -2), -2, newDecimal.New(1234, -2)},
+		{newDecimal.New(1234, -2), -4, newDecimal.New(123400, -4)},
+		{newDecimal.New(1234, -2), 0, newDecimal.New(12, 0)},
+		{newDecimal.New(-1234, -2), 0, newDecimal.New(-12, 0)},
+		{newDecimal.New(1234, -2), 2, newDecimal.New(0, 2)},
+		{newDecimal.New(1234, 0), -18, newDecimal.NewFromBigInt(new(big.Int).Mul(big.NewInt(1234), big.NewInt(1000000000000000000)), -18)},
+		{newDecimal.New(math.MaxInt64, 0), -1, newDecimal.NewFromBigInt(new(big.Int).Mul(big.NewInt(math.MaxInt64), big.NewInt(10)), -1)},
+		{newDecimal.New(math.MinInt64, 0), 19, newDecimal.New(0, 19)},
+		{newDecimal.New(math.MinInt64, 0), 18, newDecimal.New(-9, 18)},
+	}
+
+	for _, test := range tests {
+		actual := Rescale(test.n, test.exp)
+		assert.True(t, test.expected.Equal(actual), fmt.Sprintf("%s rescale(%d) = %s", test.n, test.exp, actual))
+		assert.Equal(t, test.exp, actual.Exponent())
+	}
+}
+
+func TestPow10(t *testing.T) {
+	for _, n := range []int64{0, 1, 18, 19, pow10BigLen - 1, pow10BigLen, 1000} {
+		expected := new(big.Int).Exp(big.NewInt(10), big.NewInt(n), nil)
+		assert.Equal(t, 0, expected.Cmp(pow10(n)), fmt.Sprintf("10^%d", n))
+	}
+
+	// cached values are not modified by Rescale
+	Rescale(newDecimal.NewFromBigInt(new(big.Int).Lsh(big.NewInt(1), 100), -50), 0)
+	assert.Equal(t, "100000000000000000000000000000000000000000000000000", pow10(50).String())
+}
+
+func TestDivRound(t *testing.T) {
+	tests := []struct {
+		rule   RoundRule
+		x      string
+		y      string
+		exp    int
+		result Number
+	}{
+		{RoundTruncate, "2", "3", -2, newDecimal.New(66, -2)},
+		{RoundTruncate, "-2", "3", -2, newDecimal.New(-66, -2)},
+		{RoundFloor, "2", "3", -2, newDecimal.New(66, -2)},
+		{RoundFloor, "-2", "3", -2, newDecimal.New(-67, -2)},
+		{RoundCeil, "2", "3", -2, newDecimal.New(67, -2)},
+		{RoundCeil, "-2", "3", -2, newDecimal.New(-66, -2)},
+		{RoundMath, "2", "3", -2, newDecimal.New(67, -2)},
+		{RoundMath, "1", "8", -2, newDecimal.New(13, -2)},
+		{RoundMath, "-1", "8", -2, newDecimal.New(-13, -2)},
+		{RoundBankers, "1", "8", -2, newDecimal.New(12, -2)},
+		{RoundBankers, "3", "8", -2, newDecimal.New(38, -2)},
+		{RoundBankers, "-3", "8", -2, newDecimal.New(-38, -2)},
+		// Exact quotient is not modified
+		{RoundCeil, "1.50", "0.5", -2, newDecimal.New(300, -2)},
+		// Positive exponents
+		{RoundMath, "12500", "1", 3, newDecimal.New(13, 3)},
+		{RoundBankers, "12500", "1", 3, newDecimal.New(12, 3)},
+		{RoundMath, "1", "0.003", 0, newDecimal.New(333, 0)},
+	}
+
+	for _, test := range tests {
+		x, err := FromString(test.x)
+		assert.NoError(t, err)
+		y, err := FromString(test.y)
+		assert.NoError(t, err)
+
+		assert.Equal(
+			t,
+			test.result,
+			divRound(x, y, test.exp, test.rule),
+			fmt.Sprintf("%s / %s round(%d, %d)", test.x, test.y, test.exp, test.rule),
+		)
+	}
+
+	assert.PanicsWithValue(t, `decimal division by 0: dec(coef=15, exp=-1, "1.5") / dec(coef=0, exp=-2, "0")`, func() {
+		divRound(New(15, -1), New(0, -2), 0, RoundMath)
+	})
+}
+
+func TestRatRound(t *testing.T) {
+	tests := []struct {
+		num, den int64
+		exp      int
+		rule     RoundRule
+		expected string
+	}{
+		{2, 3, -2, RoundBankers, "0.67"},
+		{-2, 3, -2, RoundTruncate, "-0.66"},
+		{1, 8, -2, RoundBankers, "0.12"},
+		{1, 8, -2, RoundMath, "0.13"},
+		{1250, 1, 2, RoundBankers, "1200"},
+		{1350, 1, 2, RoundBankers, "1400"},
+	}
+
+	for _, test := range tests {
+		actual := ratRound(big.NewRat(test.num, test.den), test.exp, test.rule)
+		assert.Equal(t, test.expected, actual.String())
+		assert.Equal(t, int32(test.exp), actual.Exponent())
+	}
+}
+
 func TestDecimalNeg(t *testing.T) {
 	tests := []struct {
 		n        Number
@@ -558,6 +842,30 @@ func BenchmarkNumberScanRoundMarshal(b *testing.B) {
 	}
 }
 
+func BenchmarkRound(b *testing.B) {
+	d := newDecimal.New(123456789123456789, -9)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Round(d, -2, RoundBankers)
+	}
+}
+
+func BenchmarkRescale(b *testing.B) {
+	d := newDecimal.New(123456789, -2)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Rescale(d, -6)
+	}
+}
+
+func BenchmarkRescaleBig(b *testing.B) {
+	d := newDecimal.NewFromBigInt(new(big.Int).Lsh(big.NewInt(1), 100), -30)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Rescale(d, -2)
+	}
+}
+
 func BenchmarkExternalNumberScanRoundMarshal(b *testing.B) {
 	var d newDecimal.Decimal
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,43 @@
+package decimaltest
+
+import (
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Comparer returns a cmp.Option comparing decimal numbers by value with Equal
+// instead of comparing their internal representation, so 1.0 equals 1.00 and
+// uninitialized Number{} equals zero.
+func Comparer() cmp.Option {
+	return cmp.Comparer(func(x, y decimal.Number) bool {
+		return x.Equal(y)
+	})
+}
+
+// AssertEqual checks that actual is numerically equal to expected and reports
+// a test error otherwise. Numbers with different exponents but equal values
+// (e.g. 1.0 and 1.00) are considered equal. It returns whether the assertion
+// succeeded.
+func AssertEqual(t testing.TB, expected, actual decimal.Number) bool {
+	t.Helper()
+	if expected.Equal(actual) {
+		return true
+	}
+	t.Errorf("Not equal:\nexpected: %s\nactual  : %s", expected, actual)
+	return false
+}
+
+// AssertInDelta checks that actual differs from expected by no more than
+// delta and reports a test error otherwise. It returns whether the assertion
+// succeeded.
+func AssertInDelta(t testing.TB, expected, actual, delta decimal.Number) bool {
+	t.Helper()
+	diff := expected.Sub(actual).Abs()
+	if diff.Cmp(delta) <= 0 {
+		return true
+	}
+	t.Errorf("Difference %s exceeds delta %s:\nexpected: %s\nactual  : %s", diff, delta, expected, actual)
+	return false
+}
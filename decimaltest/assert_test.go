@@ -0,0 +1,74 @@
+package decimaltest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder captures test errors reported by assertion helpers.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestComparer(t *testing.T) {
+	type bet struct {
+		Stake decimal.Number
+		Odds  []decimal.Number
+	}
+
+	a := bet{Stake: decimal.New(10, 0), Odds: []decimal.Number{decimal.New(150, -2), {}}}
+	b := bet{Stake: decimal.New(1000, -2), Odds: []decimal.Number{decimal.New(15, -1), decimal.Zero()}}
+	c := bet{Stake: decimal.New(1001, -2), Odds: []decimal.Number{decimal.New(15, -1), decimal.Zero()}}
+
+	assert.True(t, cmp.Equal(a, b, Comparer()))
+	assert.False(t, cmp.Equal(a, c, Comparer()))
+	assert.Contains(t, cmp.Diff(a, c, Comparer()), "Stake")
+}
+
+func TestAssertEqual(t *testing.T) {
+	tests := []struct {
+		expected, actual decimal.Number
+		ok               bool
+	}{
+		{decimal.New(1, 0), decimal.New(100, -2), true},
+		{decimal.Number{}, decimal.Zero(), true},
+		{decimal.Zero(), decimal.New(0, -5), true},
+		{decimal.New(1, 0), decimal.New(101, -2), false},
+	}
+
+	for _, test := range tests {
+		r := &recorder{}
+		assert.Equal(t, test.ok, AssertEqual(r, test.expected, test.actual))
+		assert.Equal(t, !test.ok, len(r.errors) == 1)
+	}
+}
+
+func TestAssertInDelta(t *testing.T) {
+	tests := []struct {
+		expected, actual, delta decimal.Number
+		ok                      bool
+	}{
+		{decimal.New(1, 0), decimal.New(1, 0), decimal.Zero(), true},
+		{decimal.New(1, 0), decimal.New(101, -2), decimal.New(1, -2), true},
+		{decimal.New(1, 0), decimal.New(99, -2), decimal.New(1, -2), true},
+		{decimal.New(1, 0), decimal.New(102, -2), decimal.New(1, -2), false},
+		{decimal.Number{}, decimal.New(-1, -3), decimal.New(1, -2), true},
+	}
+
+	for _, test := range tests {
+		r := &recorder{}
+		assert.Equal(t, test.ok, AssertInDelta(r, test.expected, test.actual, test.delta))
+		assert.Equal(t, !test.ok, len(r.errors) == 1)
+	}
+}
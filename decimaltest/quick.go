@@ -0,0 +1,97 @@
+package decimaltest
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"github.com/advbet/decimal/v2"
+)
+
+// Value wraps a decimal number to implement quick.Generator, so Value
+// arguments of functions passed to quick.Check are random decimal numbers.
+type Value struct {
+	decimal.Number
+}
+
+var _ quick.Generator = Value{}
+
+// Generate implements the quick.Generator interface. Size limits number of
+// coefficient digits and magnitude of the exponent.
+func (Value) Generate(r *rand.Rand, size int) reflect.Value {
+	if size < 1 {
+		size = 1
+	}
+	n := Rand(r, Digits(1, size), Exponents(-size, size))
+	return reflect.ValueOf(Value{n})
+}
+
+// fuzzSeeds are inputs known to break decimal number parsers.
+var fuzzSeeds = []string{
+	"",
+	"0",
+	"-0",
+	"0.00",
+	"1",
+	"-1",
+	"+1",
+	".1",
+	"1.",
+	".",
+	"-",
+	"-.",
+	".-2",
+	"--1",
+	"1.2.3",
+	"1e",
+	"1e+",
+	"1e-2",
+	"1E2",
+	"1e-2147483648",
+	"1e2147483647",
+	"1e2147483648",
+	"1e-9223372036854775809",
+	"9223372036854775807",
+	"9223372036854775808",
+	"-9223372036854775808",
+	"-9223372036854775809",
+	"18446744073709551616",
+	"0.000000000000000000000000000001",
+	"1234567890123456789012345678901234567890",
+	"-1234567890123456789012345678901234567890",
+	"12345678901234567890.12345678901234567890",
+	" 1",
+	"1 ",
+	"1_000",
+	"0x10",
+	"NaN",
+	"Inf",
+	"-Infinity",
+	"\x00",
+	"１",
+}
+
+// FuzzSeeds returns a new list of inputs known to break decimal number
+// parsers: malformed numbers, extreme exponents and long coefficients.
+func FuzzSeeds() []string {
+	return append([]string(nil), fuzzSeeds...)
+}
+
+// AddFuzzSeeds adds FuzzSeeds to the seed corpus of a fuzz test taking a
+// single string argument.
+func AddFuzzSeeds(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add(s)
+	}
+}
+
+// AddFuzzSeedPairs adds all pairs of FuzzSeeds to the seed corpus of a fuzz
+// test taking two string arguments, e.g. operands of a binary operation.
+func AddFuzzSeedPairs(f *testing.F) {
+	for _, a := range fuzzSeeds {
+		for _, b := range fuzzSeeds {
+			f.Add(a, b)
+		}
+	}
+}
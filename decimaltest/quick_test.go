@@ -0,0 +1,72 @@
+package decimaltest
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/advbet/decimal/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValueGenerate(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for size := 0; size < 50; size++ {
+		v := Value{}.Generate(r, size).Interface().(Value)
+		assert.NotNil(t, v.Coefficient())
+		assert.LessOrEqual(t, v.Exponent(), int32(size+1))
+		assert.GreaterOrEqual(t, v.Exponent(), int32(-size-1))
+	}
+}
+
+func TestValueQuickCheck(t *testing.T) {
+	commutative := func(a, b Value) bool {
+		return a.Add(b.Number).Equal(b.Add(a.Number))
+	}
+	assert.NoError(t, quick.Check(commutative, nil))
+
+	roundTrip := func(a Value) bool {
+		n, err := decimal.FromString(a.String())
+		return err == nil && n.Equal(a.Number)
+	}
+	assert.NoError(t, quick.Check(roundTrip, nil))
+}
+
+func TestFuzzSeeds(t *testing.T) {
+	a := FuzzSeeds()
+	assert.Contains(t, a, ".-2")
+	assert.Contains(t, a, "1e-2147483648")
+
+	a[0] = "changed"
+	assert.NotEqual(t, "changed", FuzzSeeds()[0])
+}
+
+func FuzzFromString(f *testing.F) {
+	AddFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, s string) {
+		n, err := decimal.FromString(s)
+		if err != nil {
+			return
+		}
+		assert.NotNil(t, n.Coefficient())
+	})
+}
+
+func FuzzCmp(f *testing.F) {
+	AddFuzzSeedPairs(f)
+	f.Fuzz(func(t *testing.T, a, b string) {
+		x, err := decimal.FromString(a)
+		if err != nil {
+			return
+		}
+		y, err := decimal.FromString(b)
+		if err != nil {
+			return
+		}
+		// comparing numbers of very different scale rescales coefficients
+		if d := int64(x.Exponent()) - int64(y.Exponent()); d > 1000 || d < -1000 {
+			return
+		}
+		assert.Equal(t, -decimal.Cmp(y, x), decimal.Cmp(x, y))
+	})
+}
@@ -0,0 +1,116 @@
+// Package decimaltest implements helpers for testing code working with
+// decimal numbers: random value generators and corpora of edge-case values.
+package decimaltest
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+
+	"github.com/advbet/decimal/v2"
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// Opt is an option constraining values generated by Rand.
+type Opt func(*options)
+
+type options struct {
+	minDigits, maxDigits int
+	minExp, maxExp       int
+	sign                 int
+}
+
+// Digits limits number of coefficient digits to the range [min, max]. Default
+// range is [1, 18]. It panics if min < 1 or max < min.
+func Digits(min, max int) Opt {
+	if min < 1 || max < min {
+		panic("decimaltest: invalid digits range")
+	}
+	return func(o *options) {
+		o.minDigits, o.maxDigits = min, max
+	}
+}
+
+// Exponents limits exponents of generated values to the range [min, max].
+// Default range is [-10, 10]. It panics if max < min.
+func Exponents(min, max int) Opt {
+	if max < min {
+		panic("decimaltest: invalid exponents range")
+	}
+	return func(o *options) {
+		o.minExp, o.maxExp = min, max
+	}
+}
+
+// Positive makes Rand generate only values greater than zero.
+func Positive() Opt {
+	return func(o *options) {
+		o.sign = 1
+	}
+}
+
+// Negative makes Rand generate only values less than zero.
+func Negative() Opt {
+	return func(o *options) {
+		o.sign = -1
+	}
+}
+
+// Rand returns a random decimal number within the constraints given by opts.
+// Number of coefficient digits, exponent and sign are distributed uniformly,
+// by default generated values might be zero or have leading zero digits.
+func Rand(r *rand.Rand, opts ...Opt) decimal.Number {
+	o := options{minDigits: 1, maxDigits: 18, minExp: -10, maxExp: 10}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	digits := o.minDigits + r.Intn(o.maxDigits-o.minDigits+1)
+	exp := o.minExp + int(r.Int63n(int64(o.maxExp)-int64(o.minExp)+1))
+
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)
+	coef := new(big.Int).Rand(r, limit)
+	if o.sign != 0 && coef.Sign() == 0 {
+		coef.SetInt64(1)
+	}
+	if o.sign < 0 || (o.sign == 0 && r.Intn(2) == 1) {
+		coef.Neg(coef)
+	}
+
+	return newDecimal.NewFromBigInt(coef, int32(exp))
+}
+
+// EdgeCases returns a new list of values that commonly break decimal
+// arithmetic and serialization: zeros with various exponents, coefficients
+// around int64 and uint64 limits, and extreme exponents. Operations that
+// rescale numbers with extreme exponents are slow and allocate a lot, callers
+// might want to filter those out.
+func EdgeCases() []decimal.Number {
+	maxUint64 := new(big.Int).SetUint64(math.MaxUint64)
+	aboveMaxInt64 := new(big.Int).Add(big.NewInt(math.MaxInt64), big.NewInt(1))
+	belowMinInt64 := new(big.Int).Sub(big.NewInt(math.MinInt64), big.NewInt(1))
+
+	return []decimal.Number{
+		decimal.New(0, 0),
+		decimal.New(0, -2),
+		decimal.New(0, 2),
+		decimal.New(1, 0),
+		decimal.New(-1, 0),
+		decimal.New(1, -1),
+		decimal.New(5, -1),
+		decimal.New(-5, -1),
+		decimal.New(1, -28),
+		decimal.New(math.MaxInt64, 0),
+		decimal.New(math.MinInt64, 0),
+		decimal.New(math.MaxInt64, -18),
+		decimal.New(math.MinInt64, -18),
+		newDecimal.NewFromBigInt(aboveMaxInt64, 0),
+		newDecimal.NewFromBigInt(belowMinInt64, 0),
+		newDecimal.NewFromBigInt(maxUint64, 0),
+		newDecimal.NewFromBigInt(maxUint64, -19),
+		decimal.New(1, math.MaxInt32),
+		decimal.New(-1, math.MaxInt32),
+		decimal.New(1, math.MinInt32),
+		decimal.New(-1, math.MinInt32),
+	}
+}
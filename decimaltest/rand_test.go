@@ -0,0 +1,73 @@
+package decimaltest
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRand(t *testing.T) {
+	tests := []struct {
+		opts            []Opt
+		minExp, maxExp  int32
+		maxDigits, sign int
+		allowZero       bool
+	}{
+		{nil, -10, 10, 18, 0, true},
+		{[]Opt{Digits(1, 3), Exponents(-2, -2)}, -2, -2, 3, 0, true},
+		{[]Opt{Digits(30, 40), Exponents(-5, 5), Positive()}, -5, 5, 40, 1, false},
+		{[]Opt{Digits(1, 1), Exponents(0, 0), Negative()}, 0, 0, 1, -1, false},
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i, test := range tests {
+		for j := 0; j < 1000; j++ {
+			n := Rand(r, test.opts...)
+			assert.GreaterOrEqual(t, n.Exponent(), test.minExp, i)
+			assert.LessOrEqual(t, n.Exponent(), test.maxExp, i)
+			assert.LessOrEqual(t, len(new(big.Int).Abs(n.Coefficient()).String()), test.maxDigits, i)
+			if test.sign != 0 {
+				assert.Equal(t, test.sign, n.Sign(), i)
+			}
+			if !test.allowZero {
+				assert.False(t, n.IsZero(), i)
+			}
+		}
+	}
+}
+
+func TestRandDeterministic(t *testing.T) {
+	a := Rand(rand.New(rand.NewSource(42)), Digits(20, 30))
+	b := Rand(rand.New(rand.NewSource(42)), Digits(20, 30))
+	assert.Equal(t, a, b)
+}
+
+func TestRandSigns(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	signs := map[int]int{}
+	for i := 0; i < 1000; i++ {
+		signs[Rand(r, Digits(5, 5)).Sign()]++
+	}
+	assert.Greater(t, signs[1], 400)
+	assert.Greater(t, signs[-1], 400)
+}
+
+func TestInvalidOpts(t *testing.T) {
+	assert.Panics(t, func() { Digits(0, 1) })
+	assert.Panics(t, func() { Digits(3, 2) })
+	assert.Panics(t, func() { Exponents(1, 0) })
+}
+
+func TestEdgeCases(t *testing.T) {
+	a := EdgeCases()
+	assert.NotEmpty(t, a)
+	for _, n := range a {
+		assert.NotNil(t, n.Coefficient())
+	}
+
+	// every call returns a fresh list
+	a[0] = a[1]
+	assert.NotEqual(t, a[0].Exponent(), EdgeCases()[0].Exponent())
+}
@@ -0,0 +1,22 @@
+package decimal
+
+// Div calculates x / y and rounds the quotient to the given exponent using the
+// given rounding rule. Rounding is applied to the exact quotient, the result
+// does not depend on any division precision setting. Division by zero returns
+// ErrDivisionByZero, use Config.DivRound for other division by zero policies.
+func Div(x, y Number, exp int, rule RoundRule) (Number, error) {
+	if y.IsZero() {
+		return Number{}, ErrDivisionByZero
+	}
+	return divRound(x, y, exp, rule), nil
+}
+
+// DivInt calculates x / n, see Div for rounding and error handling.
+func DivInt(x Number, n int, exp int, rule RoundRule) (Number, error) {
+	return Div(x, New(int64(n), 0), exp, rule)
+}
+
+// Inv calculates 1 / x, see Div for rounding and error handling.
+func Inv(x Number, exp int, rule RoundRule) (Number, error) {
+	return Div(One, x, exp, rule)
+}
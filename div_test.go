@@ -0,0 +1,40 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiv(t *testing.T) {
+	n, err := Div(New(2, 0), New(3, 0), -4, RoundBankers)
+	assert.NoError(t, err)
+	assert.Equal(t, New(6667, -4), n)
+
+	n, err = Div(New(-1, 0), New(8, 0), -2, RoundBankers)
+	assert.NoError(t, err)
+	assert.Equal(t, New(-12, -2), n)
+
+	_, err = Div(One, Zero(), -2, RoundBankers)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+	_, err = Div(One, Number{}, -2, RoundBankers)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestDivInt(t *testing.T) {
+	n, err := DivInt(New(1000, -2), 3, -2, RoundFloor)
+	assert.NoError(t, err)
+	assert.Equal(t, New(333, -2), n)
+
+	_, err = DivInt(One, 0, -2, RoundBankers)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestInv(t *testing.T) {
+	n, err := Inv(New(4, 0), -2, RoundBankers)
+	assert.NoError(t, err)
+	assert.Equal(t, New(25, -2), n)
+
+	_, err = Inv(New(0, -3), -2, RoundBankers)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
@@ -0,0 +1,195 @@
+// Package drivertest verifies that decimal numbers survive Value and Scan
+// round trips through a database driver and column type, e.g.
+//
+//	func TestDecimalFidelity(t *testing.T) {
+//		db := openTestDatabase(t)
+//		drivertest.RoundTrip(t, db, drivertest.Config{
+//			Type:        "NUMERIC(38,18)",
+//			Placeholder: drivertest.Dollar,
+//		})
+//	}
+//
+// RoundTrip needs a live database where the test may create and drop a
+// scratch table.
+package drivertest
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// Config describes the round trip test.
+type Config struct {
+	// Table is the name of the scratch table created and dropped by the
+	// test, "decimal_drivertest" if empty.
+	Table string
+	// Type is the SQL type of tested columns, "NUMERIC(38,18)" if empty.
+	Type string
+	// Placeholder returns query placeholder of the n-th argument starting
+	// from 1, "?" if nil.
+	Placeholder func(n int) string
+	// Values are the numbers stored and scanned back, Values() if empty. All
+	// of them must fit into the column type.
+	Values []decimal.Number
+}
+
+// Question returns "?" placeholders used by MySQL and SQLite drivers.
+func Question(int) string {
+	return "?"
+}
+
+// Dollar returns "$n" placeholders used by PostgreSQL drivers.
+func Dollar(n int) string {
+	return "$" + strconv.Itoa(n)
+}
+
+// Values returns numbers fitting NUMERIC(38,18) covering zero, signs,
+// integers, the smallest fraction and the largest magnitude of the type, and
+// coefficients exceeding int64 and float64 precision.
+func Values() []decimal.Number {
+	return []decimal.Number{
+		decimal.New(0, 0),
+		decimal.New(1, 0),
+		decimal.New(-1, 0),
+		decimal.New(1, -1),
+		decimal.New(1234, -2),
+		decimal.New(-1234, -2),
+		decimal.New(1, -18),
+		decimal.New(-1, -18),
+		decimal.New(9007199254740993, 0),
+		decimal.New(123456789123456789, -9),
+		newDecimal.RequireFromString("99999999999999999999.999999999999999999"),
+		newDecimal.RequireFromString("-99999999999999999999.999999999999999999"),
+		newDecimal.RequireFromString("12345678901234567890.123456789012345678"),
+	}
+}
+
+// RoundTrip stores configured values in a scratch table using Value and scans
+// them back using Scan and decimal.ScanRow, reporting a test error for every
+// value that does not compare equal to the stored one. NULL values are stored
+// from and scanned into decimal.NullNumber. It returns whether all values
+// survived the round trip.
+func RoundTrip(t testing.TB, db *sql.DB, c Config) bool {
+	t.Helper()
+	c = withDefaults(c)
+
+	if _, err := db.Exec(fmt.Sprintf("CREATE TABLE %s (id INTEGER NOT NULL, v %s, nv %s)", c.Table, c.Type, c.Type)); err != nil {
+		t.Fatalf("drivertest: create table: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := db.Exec("DROP TABLE " + c.Table); err != nil {
+			t.Errorf("drivertest: drop table: %v", err)
+		}
+	})
+
+	insert := fmt.Sprintf("INSERT INTO %s (id, v, nv) VALUES (%s, %s, %s)",
+		c.Table, c.Placeholder(1), c.Placeholder(2), c.Placeholder(3))
+	for i, n := range c.Values {
+		if _, err := db.Exec(insert, i, n, decimal.NullNumber{Decimal: n, Valid: true}); err != nil {
+			t.Fatalf("drivertest: insert %s: %v", n, err)
+		}
+	}
+	if _, err := db.Exec(insert, len(c.Values), decimal.Zero(), decimal.NullNumber{}); err != nil {
+		t.Fatalf("drivertest: insert NULL: %v", err)
+	}
+
+	ok := true
+	for _, mode := range []string{"Scan", "ScanRow"} {
+		if !check(t, db, c, mode) {
+			ok = false
+		}
+	}
+	return ok
+}
+
+// check selects all rows of the scratch table and compares them with
+// configured values.
+func check(t testing.TB, db *sql.DB, c Config, mode string) bool {
+	t.Helper()
+
+	rows, err := db.Query(fmt.Sprintf("SELECT id, v, nv FROM %s ORDER BY id", c.Table))
+	if err != nil {
+		t.Fatalf("drivertest: select: %v", err)
+	}
+	defer rows.Close()
+
+	ok := true
+	seen := 0
+	var s decimal.RowScanner
+	for rows.Next() {
+		var id int
+		var v decimal.Number
+		var nv decimal.NullNumber
+		if mode == "ScanRow" {
+			err = s.Scan(rows, &id, &v, &nv)
+		} else {
+			err = rows.Scan(&id, &v, &nv)
+		}
+		if err != nil {
+			t.Errorf("drivertest: %s row %d: %v", mode, seen, err)
+			ok = false
+			seen++
+			continue
+		}
+		seen++
+
+		if id == len(c.Values) {
+			if nv.Valid {
+				t.Errorf("drivertest: %s: NULL scanned as %s", mode, nv.Decimal)
+				ok = false
+			}
+			continue
+		}
+		if id < 0 || id > len(c.Values) {
+			t.Errorf("drivertest: %s: unexpected row %d", mode, id)
+			ok = false
+			continue
+		}
+		expected := c.Values[id]
+		if !v.Equal(expected) {
+			t.Errorf("drivertest: %s %s: stored %s, scanned %s", mode, c.Type, expected, v)
+			ok = false
+		}
+		if !nv.Valid || !nv.Decimal.Equal(expected) {
+			t.Errorf("drivertest: %s %s NULL: stored %s, scanned %s", mode, c.Type, expected, nullString(nv))
+			ok = false
+		}
+	}
+	if err := rows.Err(); err != nil {
+		t.Errorf("drivertest: %s: %v", mode, err)
+		ok = false
+	}
+	if seen != len(c.Values)+1 {
+		t.Errorf("drivertest: %s: scanned %d rows, stored %d", mode, seen, len(c.Values)+1)
+		ok = false
+	}
+	return ok
+}
+
+func withDefaults(c Config) Config {
+	if c.Table == "" {
+		c.Table = "decimal_drivertest"
+	}
+	if c.Type == "" {
+		c.Type = "NUMERIC(38,18)"
+	}
+	if c.Placeholder == nil {
+		c.Placeholder = Question
+	}
+	if len(c.Values) == 0 {
+		c.Values = Values()
+	}
+	return c
+}
+
+func nullString(n decimal.NullNumber) string {
+	if !n.Valid {
+		return "NULL"
+	}
+	return n.Decimal.String()
+}
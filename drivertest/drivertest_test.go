@@ -0,0 +1,136 @@
+package drivertest
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder captures test errors reported by RoundTrip.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+// memDriver is a database driver storing inserted rows in memory.
+type memDriver struct {
+	conn *memConn
+}
+
+func (d *memDriver) Open(string) (driver.Conn, error) {
+	return d.conn, nil
+}
+
+// memConn stores numbers as float64 values if lossy is set.
+type memConn struct {
+	lossy   bool
+	queries []string
+	rows    [][]driver.Value
+}
+
+func (c *memConn) Prepare(query string) (driver.Stmt, error) {
+	return &memStmt{c: c, query: query}, nil
+}
+func (c *memConn) Close() error              { return nil }
+func (c *memConn) Begin() (driver.Tx, error) { return nil, driver.ErrSkip }
+
+type memStmt struct {
+	c     *memConn
+	query string
+}
+
+func (s *memStmt) Close() error  { return nil }
+func (s *memStmt) NumInput() int { return -1 }
+
+func (s *memStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.c.queries = append(s.c.queries, s.query)
+	if strings.HasPrefix(s.query, "INSERT") {
+		row := append([]driver.Value(nil), args...)
+		for i, v := range row {
+			if str, ok := v.(string); ok && s.c.lossy {
+				f, _ := strconv.ParseFloat(str, 64)
+				row[i] = []byte(strconv.FormatFloat(f, 'g', -1, 64))
+			}
+		}
+		s.c.rows = append(s.c.rows, row)
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *memStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.c.queries = append(s.c.queries, s.query)
+	return &memRows{rows: s.c.rows}, nil
+}
+
+type memRows struct {
+	rows [][]driver.Value
+	i    int
+}
+
+func (r *memRows) Columns() []string { return []string{"id", "v", "nv"} }
+func (r *memRows) Close() error      { return nil }
+func (r *memRows) Next(dest []driver.Value) error {
+	if r.i == len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.i])
+	r.i++
+	return nil
+}
+
+var drivers int
+
+func open(t *testing.T, lossy bool) (*sql.DB, *memConn) {
+	drivers++
+	name := "drivertest-mem-" + strconv.Itoa(drivers)
+	conn := &memConn{lossy: lossy}
+	sql.Register(name, &memDriver{conn: conn})
+	db, err := sql.Open(name, "")
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db, conn
+}
+
+func TestRoundTrip(t *testing.T) {
+	db, conn := open(t, false)
+	assert.True(t, RoundTrip(t, db, Config{}))
+	assert.Equal(t, "CREATE TABLE decimal_drivertest (id INTEGER NOT NULL, v NUMERIC(38,18), nv NUMERIC(38,18))", conn.queries[0])
+	assert.Equal(t, "INSERT INTO decimal_drivertest (id, v, nv) VALUES (?, ?, ?)", conn.queries[1])
+	assert.Len(t, conn.rows, len(Values())+1)
+}
+
+func TestRoundTripLossy(t *testing.T) {
+	db, conn := open(t, true)
+	r := &recorder{TB: t}
+	assert.False(t, RoundTrip(r, db, Config{
+		Table:       "scratch",
+		Type:        "DOUBLE PRECISION",
+		Placeholder: Dollar,
+		Values:      []decimal.Number{decimal.New(1234, -2), decimal.New(9007199254740993, 0)},
+	}))
+	assert.Equal(t, "INSERT INTO scratch (id, v, nv) VALUES ($1, $2, $3)", conn.queries[1])
+	assert.Equal(t, []string{
+		"drivertest: Scan DOUBLE PRECISION: stored 9007199254740993, scanned 9007199254740992",
+		"drivertest: Scan DOUBLE PRECISION NULL: stored 9007199254740993, scanned 9007199254740992",
+		"drivertest: ScanRow DOUBLE PRECISION: stored 9007199254740993, scanned 9007199254740992",
+		"drivertest: ScanRow DOUBLE PRECISION NULL: stored 9007199254740993, scanned 9007199254740992",
+	}, r.errors)
+}
+
+func TestPlaceholders(t *testing.T) {
+	assert.Equal(t, "?", Question(2))
+	assert.Equal(t, "$2", Dollar(2))
+}
@@ -0,0 +1,42 @@
+package decimal
+
+import (
+	"fmt"
+	"time"
+)
+
+// FromDuration converts duration d to a decimal number of units, e.g. 90
+// minutes in time.Hour units is 1.5, and rounds it to the given exponent
+// using the given rounding rule. It panics if unit is not positive.
+func FromDuration(d time.Duration, unit time.Duration, exp int, rule RoundRule) Number {
+	if unit <= 0 {
+		panic("decimal: duration unit must be positive")
+	}
+	return divRound(New(int64(d), 0), New(int64(unit), 0), exp, rule)
+}
+
+// ToDuration converts a decimal number of units to duration, e.g. 1.5 in
+// time.Hour units is 90 minutes. Fractional nanoseconds are rounded using the
+// given rounding rule. It fails with ErrOverflow if the duration does not fit
+// into time.Duration and panics if unit is not positive.
+func ToDuration(n Number, unit time.Duration, rule RoundRule) (time.Duration, error) {
+	if unit <= 0 {
+		panic("decimal: duration unit must be positive")
+	}
+
+	if n.Exponent() > maxIntExp && n.Sign() != 0 {
+		return 0, fmt.Errorf("%w: %s%s does not fit time.Duration", ErrOverflow, DebugString(n), unit)
+	}
+
+	ns := n.Mul(New(int64(unit), 0))
+	if belowOne(ns.Shift(1)) {
+		// |ns| < 0.1, avoid rescaling numbers with tiny exponents
+		return time.Duration(roundInt64(int64(ns.Sign()), 10, rule)), nil
+	}
+
+	v, err := ToInt64Exact(Round(ns, 0, rule))
+	if err != nil {
+		return 0, fmt.Errorf("%w: %sns does not fit time.Duration", ErrOverflow, DebugString(ns))
+	}
+	return time.Duration(v), nil
+}
@@ -0,0 +1,76 @@
+package decimal
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromDuration(t *testing.T) {
+	tests := []struct {
+		d        time.Duration
+		unit     time.Duration
+		exp      int
+		rule     RoundRule
+		expected Number
+	}{
+		{90 * time.Minute, time.Hour, -2, RoundTruncate, New(150, -2)},
+		{100 * time.Minute, time.Hour, -2, RoundTruncate, New(166, -2)},
+		{100 * time.Minute, time.Hour, -2, RoundMath, New(167, -2)},
+		{-100 * time.Minute, time.Hour, -2, RoundFloor, New(-167, -2)},
+		{-100 * time.Minute, time.Hour, -2, RoundCeil, New(-166, -2)},
+		{1500 * time.Millisecond, time.Second, 0, RoundBankers, New(2, 0)},
+		{2500 * time.Millisecond, time.Second, 0, RoundBankers, New(2, 0)},
+		{time.Nanosecond, time.Second, -9, RoundTruncate, New(1, -9)},
+		{0, time.Minute, -2, RoundMath, New(0, -2)},
+		{math.MaxInt64, time.Nanosecond, 0, RoundMath, New(math.MaxInt64, 0)},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, FromDuration(test.d, test.unit, test.exp, test.rule), test.d.String())
+	}
+
+	assert.Panics(t, func() { FromDuration(time.Second, 0, 0, RoundMath) })
+}
+
+func TestToDuration(t *testing.T) {
+	tests := []struct {
+		n        string
+		unit     time.Duration
+		rule     RoundRule
+		expected time.Duration
+	}{
+		{"1.5", time.Hour, RoundTruncate, 90 * time.Minute},
+		{"-0.25", time.Minute, RoundTruncate, -15 * time.Second},
+		{"0.0000000015", time.Second, RoundTruncate, time.Nanosecond},
+		{"0.0000000015", time.Second, RoundMath, 2 * time.Nanosecond},
+		{"0.0000000025", time.Second, RoundBankers, 2 * time.Nanosecond},
+		{"-0.0000000015", time.Second, RoundFloor, -2 * time.Nanosecond},
+		{"0.00000000001", time.Second, RoundMath, 0},
+		{"0.00000000001", time.Second, RoundCeil, time.Nanosecond},
+		{"-0.00000000001", time.Second, RoundFloor, -time.Nanosecond},
+		{"1e-2147483648", time.Hour, RoundCeil, time.Nanosecond},
+		{"1e-2147483648", time.Hour, RoundMath, 0},
+		{"9223372036854775807", time.Nanosecond, RoundMath, math.MaxInt64},
+		{"0", time.Hour, RoundMath, 0},
+	}
+
+	for _, test := range tests {
+		d, err := ToDuration(newDecimal.RequireFromString(test.n), test.unit, test.rule)
+		assert.NoError(t, err, test.n)
+		assert.Equal(t, test.expected, d, test.n)
+	}
+
+	for _, str := range []string{"9223372036854775808", "2562048", "1e2147483647", "-1e30"} {
+		_, err := ToDuration(newDecimal.RequireFromString(str), time.Hour, RoundMath)
+		assert.ErrorIs(t, err, ErrOverflow, str)
+	}
+
+	d, err := ToDuration(Number{}, time.Hour, RoundMath)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), d)
+	assert.Panics(t, func() { _, _ = ToDuration(One, -time.Second, RoundMath) })
+}
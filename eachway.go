@@ -0,0 +1,39 @@
+package decimal
+
+// EachWayTerms describes place part of each-way bets, e.g. 1/4 odds for the
+// first 3 places.
+type EachWayTerms struct {
+	Num    int64 // Num is the numerator of the place odds fraction
+	Den    int64 // Den is the denominator of the place odds fraction
+	Places int   // Places is the number of paid places, including the winner
+}
+
+// PlaceOdds returns decimal odds of the place part 1 + (winOdds - 1) * Num /
+// Den rounded once to the given exponent using the given rounding rule. It
+// panics if Den is zero.
+func (t EachWayTerms) PlaceOdds(winOdds Number, exp int, rule RoundRule) Number {
+	// 1 + (w - 1) * num / den = (den + (w - 1) * num) / den
+	num := winOdds.Sub(One).Mul(New(t.Num, 0)).Add(New(t.Den, 0))
+	return divRound(num, New(t.Den, 0), exp, rule)
+}
+
+// EachWayReturn calculates total return of an each-way bet consisting of a win
+// bet and a place bet of stakePerPart each. Position is the finishing position
+// of the selection starting from 1, zero means the selection did not finish.
+// The winner is paid both parts, other paid places only the place part. Every
+// part is rounded once according to the policy, the place part is rounded
+// from the exact place odds. The policy cap limits the total return.
+func EachWayReturn(stakePerPart, winOdds Number, position int, terms EachWayTerms, policy PayoutPolicy) Number {
+	if position < 1 || position > terms.Places {
+		return Round(Zero(), policy.Exp, policy.Rule)
+	}
+
+	// stake * (1 + (w - 1) * num / den) = stake * (den + (w - 1) * num) / den
+	placeNum := stakePerPart.Mul(winOdds.Sub(One).Mul(New(terms.Num, 0)).Add(New(terms.Den, 0)))
+	place := divRound(placeNum, New(terms.Den, 0), policy.Exp, policy.Rule)
+	if position > 1 {
+		return policy.limit(place)
+	}
+	win := Round(stakePerPart.Mul(winOdds), policy.Exp, policy.Rule)
+	return policy.limit(win.Add(place))
+}
@@ -0,0 +1,39 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEachWayPlaceOdds(t *testing.T) {
+	quarter := EachWayTerms{Num: 1, Den: 4, Places: 3}
+	fifth := EachWayTerms{Num: 1, Den: 5, Places: 4}
+
+	assert.Equal(t, "3", quarter.PlaceOdds(New(9, 0), -2, RoundBankers).String())
+	assert.Equal(t, "1.63", quarter.PlaceOdds(New(35, -1), -2, RoundMath).String())
+	assert.Equal(t, "1.62", quarter.PlaceOdds(New(35, -1), -2, RoundBankers).String())
+	assert.Equal(t, "1.62", quarter.PlaceOdds(New(35, -1), -2, RoundTruncate).String())
+	assert.Equal(t, "1.5", fifth.PlaceOdds(New(35, -1), -2, RoundBankers).String())
+	assert.Equal(t, "1.333", EachWayTerms{Num: 1, Den: 3}.PlaceOdds(New(2, 0), -3, RoundBankers).String())
+}
+
+func TestEachWayReturn(t *testing.T) {
+	terms := EachWayTerms{Num: 1, Den: 4, Places: 3}
+	policy := PayoutPolicy{Exp: -2, Rule: RoundTruncate}
+	stake := New(1000, -2)
+	odds := New(35, -1)
+
+	assert.Equal(t, "51.25", EachWayReturn(stake, odds, 1, terms, policy).String())
+	assert.Equal(t, "16.25", EachWayReturn(stake, odds, 2, terms, policy).String())
+	assert.Equal(t, "16.25", EachWayReturn(stake, odds, 3, terms, policy).String())
+	assert.Equal(t, "0", EachWayReturn(stake, odds, 4, terms, policy).String())
+	assert.Equal(t, "0", EachWayReturn(stake, odds, 0, terms, policy).String())
+
+	// exact place odds are 1.20825, rounded place odds 1.21 would pay 12.10
+	assert.Equal(t, "12.08", EachWayReturn(New(10, 0), New(1833, -3), 2, terms, policy).String())
+
+	policy.Cap = New(50, 0)
+	assert.Equal(t, "50", EachWayReturn(stake, odds, 1, terms, policy).String())
+	assert.Equal(t, "16.25", EachWayReturn(stake, odds, 2, terms, policy).String())
+}
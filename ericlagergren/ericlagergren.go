@@ -0,0 +1,33 @@
+// Package ericlagergren converts decimal numbers to and from
+// github.com/ericlagergren/decimal values without string round-trips.
+package ericlagergren
+
+import (
+	"math/big"
+
+	"github.com/advbet/decimal/v2"
+	eld "github.com/ericlagergren/decimal"
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// FromBig converts ericlagergren decimal to a decimal number. The conversion
+// is always exact, scale of the value is preserved. It returns
+// decimal.ErrNotFinite for NaN and infinite values.
+func FromBig(x *eld.Big) (decimal.Number, error) {
+	if !x.IsFinite() {
+		return decimal.Number{}, decimal.ErrNotFinite
+	}
+
+	_, neg, digits, exp := x.Decompose(nil)
+	coef := new(big.Int).SetBytes(digits)
+	if neg {
+		coef.Neg(coef)
+	}
+	return newDecimal.NewFromBigInt(coef, exp), nil
+}
+
+// ToBig converts decimal number to ericlagergren decimal. The conversion is
+// always exact, scale of the number is preserved.
+func ToBig(n decimal.Number) *eld.Big {
+	return new(eld.Big).SetBigMantScale(n.Coefficient(), -int(n.Exponent()))
+}
@@ -0,0 +1,48 @@
+package ericlagergren
+
+import (
+	"math"
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	eld "github.com/ericlagergren/decimal"
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundTrip(t *testing.T) {
+	inputs := []string{
+		"0", "0.00", "1", "-1.50", "123.456", "-0.0000000000000000001",
+		"9223372036854775808", "-123456789012345678901234567890.123456789",
+	}
+
+	for _, in := range inputs {
+		x, ok := new(eld.Big).SetString(in)
+		assert.True(t, ok, in)
+
+		n, err := FromBig(x)
+		assert.NoError(t, err, in)
+		assert.Equal(t, newDecimal.RequireFromString(in), n, in)
+
+		back := ToBig(n)
+		assert.Equal(t, 0, back.Cmp(x), in)
+		assert.Equal(t, x.Scale(), back.Scale(), in)
+		assert.Equal(t, x.String(), back.String(), in)
+	}
+}
+
+func TestToBig(t *testing.T) {
+	assert.Equal(t, "1.5E+3", ToBig(decimal.New(15, 2)).String())
+	assert.Equal(t, "0", ToBig(decimal.Number{}).String())
+}
+
+func TestFromBigNotFinite(t *testing.T) {
+	for _, x := range []*eld.Big{
+		new(eld.Big).SetNaN(false),
+		new(eld.Big).SetInf(true),
+		new(eld.Big).SetFloat64(math.Inf(1)),
+	} {
+		_, err := FromBig(x)
+		assert.ErrorIs(t, err, decimal.ErrNotFinite)
+	}
+}
@@ -0,0 +1,18 @@
+module github.com/advbet/decimal/v2/ericlagergren
+
+go 1.18
+
+require (
+	github.com/advbet/decimal/v2 v2.0.0-00010101000000-000000000000
+	github.com/ericlagergren/decimal v0.0.0-20240411145413-00de7ca16731
+	github.com/shopspring/decimal v1.3.1
+	github.com/stretchr/testify v1.7.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
+)
+
+replace github.com/advbet/decimal/v2 => ../
@@ -0,0 +1,90 @@
+package decimal
+
+import (
+	"errors"
+)
+
+// List of errors returned by the package functions. Errors might be wrapped
+// with additional context, use errors.Is to check for them.
+var (
+	// ErrParse is returned when a string is not a valid decimal number.
+	ErrParse = errors.New("decimal: invalid number")
+	// ErrOverflow is returned when a result does not fit into the target
+	// type or the exponent range.
+	ErrOverflow = errors.New("decimal: overflow")
+	// ErrPrecisionLoss is returned when an operation would need rounding that
+	// was not requested explicitly.
+	ErrPrecisionLoss = errors.New("decimal: precision loss")
+	// ErrDivisionByZero is returned when dividing by zero.
+	ErrDivisionByZero = errors.New("decimal: division by zero")
+	// ErrCurrencyMismatch is returned when amounts in different currencies
+	// are combined.
+	ErrCurrencyMismatch = errors.New("decimal: currency mismatch")
+	// ErrUnknownCurrency is returned when a currency code has no known minor
+	// unit exponent.
+	ErrUnknownCurrency = errors.New("decimal: unknown currency")
+	// ErrUnknownRate is returned when an exchange rate can not be found or
+	// derived from the rate table.
+	ErrUnknownRate = errors.New("decimal: unknown exchange rate")
+	// ErrInvalidRate is returned when an exchange rate is not positive.
+	ErrInvalidRate = errors.New("decimal: exchange rate must be positive")
+	// ErrInvalidPercentile is returned when a percentile is not between 0
+	// and 100.
+	ErrInvalidPercentile = errors.New("decimal: percentile must be between 0 and 100")
+	// ErrInvalidOdds is returned when odds are out of their valid range.
+	ErrInvalidOdds = errors.New("decimal: invalid odds")
+	// ErrInvalidLadder is returned when tick ranges do not form a valid
+	// ladder.
+	ErrInvalidLadder = errors.New("decimal: invalid ladder")
+	// ErrInvalidTiers is returned when breakpoints and rates do not form a
+	// valid rate schedule.
+	ErrInvalidTiers = errors.New("decimal: invalid tiers")
+	// ErrUnknownInstrument is returned when an instrument has no registered
+	// tick size.
+	ErrUnknownInstrument = errors.New("decimal: unknown instrument")
+	// ErrInvalidPrice is returned when a price is not valid for an
+	// instrument.
+	ErrInvalidPrice = errors.New("decimal: invalid price")
+	// ErrLimit is matched by all *LimitError errors.
+	ErrLimit = errors.New("decimal: limit violated")
+	// ErrOutOfBounds is returned when a decoded number exceeds configured
+	// exponent or coefficient size bounds, see Bounds. It is only returned by
+	// decoders, calculations use ErrOutOfRange.
+	ErrOutOfBounds = errors.New("decimal: number out of bounds")
+	// ErrNull is returned when a NULL database value is scanned into a
+	// non-nullable number.
+	ErrNull = errors.New("decimal: unexpected NULL value")
+	// ErrInvalidCurve is returned when points do not form a valid curve.
+	ErrInvalidCurve = errors.New("decimal: invalid curve")
+	// ErrOutOfRange is returned when a calculation argument is outside the
+	// domain of a function that does not extrapolate, e.g. a Curve using
+	// ExtrapolateError. Decoding limits use ErrOutOfBounds instead.
+	ErrOutOfRange = errors.New("decimal: value out of range")
+	// ErrInvalidSplits is returned when split weights are negative or do not
+	// sum to 1.
+	ErrInvalidSplits = errors.New("decimal: invalid splits")
+	// ErrNotFinite is returned when NaN or an infinity is used where a
+	// finite number is required.
+	ErrNotFinite = errors.New("decimal: not a finite number")
+)
+
+// ParseError describes a failure to parse a decimal number. It matches
+// ErrParse when checked with errors.Is.
+type ParseError struct {
+	Input string // Input is the string that failed to parse
+	Err   error  // Err is the underlying reason of the failure
+}
+
+func (e *ParseError) Error() string {
+	return "decimal: can't parse " + `"` + e.Input + `"` + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying reason of the failure.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the error matches ErrParse.
+func (e *ParseError) Is(target error) bool {
+	return target == ErrParse
+}
@@ -0,0 +1,48 @@
+package decimal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseError(t *testing.T) {
+	_, err := FromString("1.a2")
+	assert.ErrorIs(t, err, ErrParse)
+
+	var perr *ParseError
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, "1.a2", perr.Input)
+	assert.Equal(t, `decimal: can't parse "1.a2": can't convert 1.a2 to decimal`, err.Error())
+
+	c := DefaultConfig()
+	c.Strict = true
+	_, err = c.Parse("+1")
+	assert.ErrorIs(t, err, ErrParse)
+	assert.Equal(t, `decimal: can't parse "+1": not a plain decimal number`, err.Error())
+
+	var p Percent
+	assert.ErrorIs(t, p.UnmarshalText([]byte("x%")), ErrParse)
+	var bp BasisPoints
+	assert.ErrorIs(t, bp.UnmarshalText([]byte("xbp")), ErrParse)
+}
+
+func TestErrorsAreDistinct(t *testing.T) {
+	errs := []error{
+		ErrParse,
+		ErrOverflow,
+		ErrPrecisionLoss,
+		ErrDivisionByZero,
+		ErrCurrencyMismatch,
+		ErrUnknownCurrency,
+		ErrUnknownRate,
+		ErrInvalidRate,
+	}
+
+	for i, a := range errs {
+		for j, b := range errs {
+			assert.Equal(t, i == j, errors.Is(a, b), a.Error()+" is "+b.Error())
+		}
+	}
+}
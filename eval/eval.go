@@ -0,0 +1,108 @@
+// Package eval parses and evaluates arithmetic expressions over decimal
+// numbers, e.g. "stake * odds * (1 - margin)".
+//
+// Supported syntax: decimal literals, variables (letters, digits and
+// underscores, not starting with a digit), binary operators + - * /, unary
+// minus and parentheses. Multiplication and division bind stronger than
+// addition and subtraction, operators of equal precedence are left
+// associative.
+package eval
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/advbet/decimal/v2"
+)
+
+// ErrUnknownVariable is returned when expression refers to a variable that
+// has no value.
+var ErrUnknownVariable = errors.New("eval: unknown variable")
+
+// SyntaxError describes a failure to parse an expression.
+type SyntaxError struct {
+	Pos int    // Pos is the byte offset of the error in the expression
+	Msg string // Msg describes the error
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("eval: syntax error at %d: %s", e.Pos, e.Msg)
+}
+
+// Context defines rounding of the evaluation results.
+type Context struct {
+	// Exp is the exponent the final result is rounded to.
+	Exp int
+	// Rule is the rounding rule used for the final result and intermediate
+	// quotients.
+	Rule decimal.RoundRule
+	// DivisionPrecision is the number of decimal places intermediate
+	// quotients are rounded to. Zero value selects 16 decimal places.
+	DivisionPrecision int
+}
+
+// Expr is a parsed expression. It is immutable and safe for concurrent use.
+type Expr struct {
+	src  string
+	root node
+}
+
+// Parse parses an arithmetic expression.
+func Parse(src string) (*Expr, error) {
+	p := parser{lex: lexer{src: src}}
+	p.next()
+
+	root, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokEOF {
+		return nil, &SyntaxError{Pos: p.tok.pos, Msg: "unexpected " + p.tok.String()}
+	}
+
+	return &Expr{src: src, root: root}, nil
+}
+
+// String returns source of the expression.
+func (e *Expr) String() string {
+	return e.src
+}
+
+// Vars returns names of the variables used in the expression, in order of
+// their first appearance.
+func (e *Expr) Vars() []string {
+	var names []string
+	seen := make(map[string]bool)
+	walk(e.root, func(n node) {
+		if v, ok := n.(variable); ok && !seen[string(v)] {
+			seen[string(v)] = true
+			names = append(names, string(v))
+		}
+	})
+	return names
+}
+
+// Eval evaluates the expression with given variable values. Multiplication,
+// addition and subtraction are exact, quotients are rounded to
+// ctx.DivisionPrecision decimal places and the final result is rounded to
+// ctx.Exp, both using ctx.Rule.
+func (e *Expr) Eval(ctx Context, vars map[string]decimal.Number) (decimal.Number, error) {
+	if ctx.DivisionPrecision == 0 {
+		ctx.DivisionPrecision = 16
+	}
+
+	calc, err := e.root.eval(ctx, vars)
+	if err != nil {
+		return decimal.Number{}, err
+	}
+	return calc.Round(ctx.Exp, ctx.Rule).Result()
+}
+
+// Eval parses and evaluates an expression in a single step.
+func Eval(src string, ctx Context, vars map[string]decimal.Number) (decimal.Number, error) {
+	e, err := Parse(src)
+	if err != nil {
+		return decimal.Number{}, err
+	}
+	return e.Eval(ctx, vars)
+}
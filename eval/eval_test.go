@@ -0,0 +1,102 @@
+package eval
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEval(t *testing.T) {
+	vars := map[string]decimal.Number{
+		"stake":  decimal.New(1000, -2),
+		"odds":   decimal.New(185, -2),
+		"margin": decimal.New(5, -2),
+		"legs":   decimal.New(3, 0),
+	}
+	ctx := Context{Exp: -2, Rule: decimal.RoundBankers}
+
+	tests := []struct {
+		expr     string
+		expected decimal.Number
+	}{
+		{"stake * odds * (1 - margin)", newDecimal.New(1758, -2)},
+		{"stake*odds*(1-margin)", newDecimal.New(1758, -2)},
+		{"1 + 2 * 3", newDecimal.New(700, -2)},
+		{"(1 + 2) * 3", newDecimal.New(900, -2)},
+		{"10 - 4 - 3", newDecimal.New(300, -2)},
+		{"-stake + 1", newDecimal.New(-900, -2)},
+		{"--1", newDecimal.New(100, -2)},
+		{"stake / legs", newDecimal.New(333, -2)},
+		{"stake / legs * legs", newDecimal.New(1000, -2)},
+		{"12 / 4 / 3", newDecimal.New(100, -2)},
+		{"0.125", newDecimal.New(12, -2)},
+	}
+
+	for _, test := range tests {
+		actual, err := Eval(test.expr, ctx, vars)
+		assert.NoError(t, err, test.expr)
+		assert.Equal(t, test.expected, actual, test.expr)
+	}
+}
+
+func TestEvalDivisionPrecision(t *testing.T) {
+	e, err := Parse("1 / 3 * 3")
+	assert.NoError(t, err)
+
+	res, err := e.Eval(Context{Exp: -4, Rule: decimal.RoundMath, DivisionPrecision: 2}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, newDecimal.New(9900, -4), res)
+
+	res, err = e.Eval(Context{Exp: -4, Rule: decimal.RoundMath}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, newDecimal.New(10000, -4), res)
+}
+
+func TestEvalErrors(t *testing.T) {
+	ctx := Context{Exp: -2, Rule: decimal.RoundMath}
+
+	_, err := Eval("stake * odds", ctx, map[string]decimal.Number{"stake": decimal.New(1, 0)})
+	assert.ErrorIs(t, err, ErrUnknownVariable)
+	assert.EqualError(t, err, "eval: unknown variable: odds")
+
+	_, err = Eval("1 / (x - 1)", ctx, map[string]decimal.Number{"x": decimal.New(1, 0)})
+	assert.ErrorIs(t, err, decimal.ErrDivisionByZero)
+
+	_, err = Eval("-(1 / 0)", ctx, nil)
+	assert.ErrorIs(t, err, decimal.ErrDivisionByZero)
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		expr string
+		pos  int
+	}{
+		{"", 0},
+		{"1 +", 3},
+		{"(1 + 2", 6},
+		{"1 + 2)", 5},
+		{"1 % 2", 2},
+		{"1.2.3", 0},
+		{".5", 0},
+		{"stake odds", 6},
+		{"* 2", 0},
+	}
+
+	for _, test := range tests {
+		_, err := Parse(test.expr)
+		var serr *SyntaxError
+		if assert.True(t, errors.As(err, &serr), test.expr) {
+			assert.Equal(t, test.pos, serr.Pos, test.expr+": "+err.Error())
+		}
+	}
+}
+
+func TestExprVars(t *testing.T) {
+	e, err := Parse("stake * odds - stake / (1 + bonus_2)")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"stake", "odds", "bonus_2"}, e.Vars())
+	assert.Equal(t, "stake * odds - stake / (1 + bonus_2)", e.String())
+}
@@ -0,0 +1,91 @@
+package eval
+
+import (
+	"fmt"
+
+	"github.com/advbet/decimal/v2"
+)
+
+// node is an element of the parsed expression tree.
+type node interface {
+	eval(ctx Context, vars map[string]decimal.Number) (decimal.Calculation, error)
+}
+
+type literal struct {
+	n decimal.Number
+}
+
+type variable string
+
+type negation struct {
+	x node
+}
+
+type binary struct {
+	op   byte
+	x, y node
+}
+
+func (n literal) eval(Context, map[string]decimal.Number) (decimal.Calculation, error) {
+	return decimal.Calc(n.n), nil
+}
+
+func (n variable) eval(_ Context, vars map[string]decimal.Number) (decimal.Calculation, error) {
+	v, ok := vars[string(n)]
+	if !ok {
+		return decimal.Calculation{}, fmt.Errorf("%w: %s", ErrUnknownVariable, string(n))
+	}
+	return decimal.Calc(v), nil
+}
+
+func (n negation) eval(ctx Context, vars map[string]decimal.Number) (decimal.Calculation, error) {
+	x, err := value(n.x, ctx, vars)
+	if err != nil {
+		return decimal.Calculation{}, err
+	}
+	return decimal.Calc(x.Neg()), nil
+}
+
+func (n binary) eval(ctx Context, vars map[string]decimal.Number) (decimal.Calculation, error) {
+	x, err := n.x.eval(ctx, vars)
+	if err != nil {
+		return decimal.Calculation{}, err
+	}
+	y, err := value(n.y, ctx, vars)
+	if err != nil {
+		return decimal.Calculation{}, err
+	}
+
+	switch n.op {
+	case '+':
+		return x.Add(y), nil
+	case '-':
+		return x.Sub(y), nil
+	case '*':
+		return x.Mul(y), nil
+	default:
+		return x.Div(y, -ctx.DivisionPrecision, ctx.Rule), nil
+	}
+}
+
+// value evaluates a node and returns its value or the first calculation
+// error.
+func value(n node, ctx Context, vars map[string]decimal.Number) (decimal.Number, error) {
+	calc, err := n.eval(ctx, vars)
+	if err != nil {
+		return decimal.Number{}, err
+	}
+	return calc.Result()
+}
+
+// walk calls fn for every node of the tree in depth-first order.
+func walk(n node, fn func(node)) {
+	fn(n)
+	switch n := n.(type) {
+	case negation:
+		walk(n.x, fn)
+	case binary:
+		walk(n.x, fn)
+		walk(n.y, fn)
+	}
+}
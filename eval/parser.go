@@ -0,0 +1,185 @@
+package eval
+
+import (
+	"strconv"
+
+	"github.com/advbet/decimal/v2"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokIdent
+	tokOp
+	tokLParen
+	tokRParen
+	tokInvalid
+)
+
+type token struct {
+	kind tokenKind
+	pos  int
+	text string
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of expression"
+	default:
+		return strconv.Quote(t.text)
+	}
+}
+
+type lexer struct {
+	src string
+	pos int
+}
+
+func (l *lexer) next() token {
+	for l.pos < len(l.src) && (l.src[l.pos] == ' ' || l.src[l.pos] == '\t') {
+		l.pos++
+	}
+	if l.pos >= len(l.src) {
+		return token{kind: tokEOF, pos: l.pos}
+	}
+
+	start := l.pos
+	c := l.src[l.pos]
+	switch {
+	case isDigit(c) || c == '.':
+		for l.pos < len(l.src) && (isDigit(l.src[l.pos]) || l.src[l.pos] == '.') {
+			l.pos++
+		}
+		return token{kind: tokNumber, pos: start, text: l.src[start:l.pos]}
+	case isLetter(c):
+		for l.pos < len(l.src) && (isLetter(l.src[l.pos]) || isDigit(l.src[l.pos])) {
+			l.pos++
+		}
+		return token{kind: tokIdent, pos: start, text: l.src[start:l.pos]}
+	case c == '+' || c == '-' || c == '*' || c == '/':
+		l.pos++
+		return token{kind: tokOp, pos: start, text: l.src[start:l.pos]}
+	case c == '(':
+		l.pos++
+		return token{kind: tokLParen, pos: start, text: "("}
+	case c == ')':
+		l.pos++
+		return token{kind: tokRParen, pos: start, text: ")"}
+	default:
+		l.pos++
+		return token{kind: tokInvalid, pos: start, text: l.src[start:l.pos]}
+	}
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// parser is a recursive descent parser of the grammar:
+//
+//	sum     = product { ("+" | "-") product }
+//	product = unary { ("*" | "/") unary }
+//	unary   = "-" unary | primary
+//	primary = number | ident | "(" sum ")"
+type parser struct {
+	lex lexer
+	tok token
+}
+
+func (p *parser) next() {
+	p.tok = p.lex.next()
+}
+
+func (p *parser) isOp(ops string) bool {
+	if p.tok.kind != tokOp {
+		return false
+	}
+	for i := 0; i < len(ops); i++ {
+		if p.tok.text[0] == ops[i] {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) parseSum() (node, error) {
+	x, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("+-") {
+		op := p.tok.text[0]
+		p.next()
+		y, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		x = binary{op: op, x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *parser) parseProduct() (node, error) {
+	x, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("*/") {
+		op := p.tok.text[0]
+		p.next()
+		y, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		x = binary{op: op, x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.isOp("-") {
+		p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return negation{x: x}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.tok
+	switch tok.kind {
+	case tokNumber:
+		n, err := decimal.Config{Strict: true}.Parse(tok.text)
+		if err != nil {
+			return nil, &SyntaxError{Pos: tok.pos, Msg: "invalid number " + tok.String()}
+		}
+		p.next()
+		return literal{n: n}, nil
+	case tokIdent:
+		p.next()
+		return variable(tok.text), nil
+	case tokLParen:
+		p.next()
+		x, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokRParen {
+			return nil, &SyntaxError{Pos: p.tok.pos, Msg: "expected \")\", got " + p.tok.String()}
+		}
+		p.next()
+		return x, nil
+	default:
+		return nil, &SyntaxError{Pos: tok.pos, Msg: "unexpected " + tok.String()}
+	}
+}
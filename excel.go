@@ -0,0 +1,138 @@
+package decimal
+
+import (
+	"errors"
+	"strings"
+)
+
+var errAmbiguousComma = errors.New("comma might be a decimal comma or digit grouping")
+
+// ExcelPrecision is the number of significant digits spreadsheet applications
+// keep in numeric cells.
+const ExcelPrecision = 15
+
+// IsExcelExact reports if n has at most ExcelPrecision significant digits
+// and survives a round trip through a numeric spreadsheet cell. Trailing zeros
+// of the coefficient are not significant.
+func IsExcelExact(n Number) bool {
+	coef := normalize(n).Coefficient()
+	return coef.Abs(coef).Cmp(pow10(ExcelPrecision)) < 0
+}
+
+// StringExcelSafe returns representation of n that spreadsheet applications
+// import without corrupting it, e.g. in CSV exports. Numbers exact in
+// spreadsheets are returned as plain decimal strings without exponent, other
+// numbers as text formulas, e.g. ="12345678901234567890", which keep all
+// digits. ParseExcel accepts both forms.
+func StringExcelSafe(n Number) string {
+	str := String(n)
+	if IsExcelExact(n) {
+		return str
+	}
+	return `="` + str + `"`
+}
+
+// ParseExcel parses a decimal number copied from a spreadsheet or exported by
+// it. In addition to the syntax accepted by FromString it tolerates:
+//
+//   - surrounding whitespace including non-breaking spaces
+//   - leading and trailing apostrophes used to mark text cells
+//   - text formulas ="..." produced by StringExcelSafe
+//   - negative numbers in accounting parentheses, e.g. (12.34)
+//   - decimal comma, e.g. 1,23E+5 or 12,5
+//   - digit grouping with spaces, commas or dots, e.g. 1 234,5 or 1,234.5
+//
+// A single comma without dots is a decimal comma, otherwise the last of
+// comma or dot is the decimal separator and the other is digit grouping. A
+// single comma followed by exactly three digits, e.g. 1,234, is rejected
+// unless the number is grouped with spaces or the integer part is zero or
+// longer than three digits, since it might as well be a thousands separator.
+// Returned error is a *ParseError holding the original input.
+func ParseExcel(str string) (Number, error) {
+	cleaned, err := cleanExcel(str)
+	if err != nil {
+		return Number{}, &ParseError{Input: str, Err: err}
+	}
+	n, err := FromString(cleaned)
+	if err != nil {
+		var perr *ParseError
+		if errors.As(err, &perr) {
+			err = perr.Err
+		}
+		return Number{}, &ParseError{Input: str, Err: err}
+	}
+	return n, nil
+}
+
+// cleanExcel removes spreadsheet artifacts from a number.
+func cleanExcel(str string) (string, error) {
+	s := trimExcel(str)
+	if strings.HasPrefix(s, `="`) && strings.HasSuffix(s, `"`) && len(s) >= 3 {
+		s = trimExcel(s[2 : len(s)-1])
+	}
+	s = strings.Trim(s, "'")
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		s = "-" + trimExcel(s[1:len(s)-1])
+	}
+	spaced := false
+	s = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\u00a0', '\u202f', '\u2009':
+			spaced = true
+			return -1
+		}
+		return r
+	}, s)
+
+	comma, dot := strings.LastIndexByte(s, ','), strings.LastIndexByte(s, '.')
+	switch {
+	case comma < 0:
+		if strings.Count(s, ".") > 1 {
+			s = strings.ReplaceAll(s, ".", "")
+		}
+	case dot < 0 && strings.Count(s, ",") == 1:
+		if !spaced && ambiguousComma(s, comma) {
+			return "", errAmbiguousComma
+		}
+		s = strings.Replace(s, ",", ".", 1)
+	case dot < 0:
+		s = strings.ReplaceAll(s, ",", "")
+	case comma > dot:
+		s = strings.ReplaceAll(s, ".", "")
+		s = strings.Replace(s, ",", ".", 1)
+	default:
+		s = strings.ReplaceAll(s, ",", "")
+	}
+	return s, nil
+}
+
+// ambiguousComma checks if a single comma at the given index separates one to
+// three integer digits not starting with zero from exactly three digits, e.g.
+// 1,234 or -12,500.
+func ambiguousComma(s string, comma int) bool {
+	integer := strings.TrimLeft(s[:comma], "+-")
+	fraction := s[comma+1:]
+	return len(integer) >= 1 && len(integer) <= 3 && integer[0] != '0' &&
+		len(fraction) == 3 && isDigits(integer) && isDigits(fraction)
+}
+
+// isDigits checks if s consists of ASCII digits only.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// trimExcel removes surrounding whitespace including non-breaking spaces.
+func trimExcel(s string) string {
+	return strings.TrimFunc(s, func(r rune) bool {
+		switch r {
+		case ' ', '\t', '\r', '\n', '\u00a0', '\u202f', '\u2009':
+			return true
+		}
+		return false
+	})
+}
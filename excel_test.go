@@ -0,0 +1,86 @@
+package decimal
+
+import (
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringExcelSafe(t *testing.T) {
+	tests := []struct {
+		n        Number
+		expected string
+	}{
+		{New(1234, -2), "12.34"},
+		{New(-1, -30), "-0.000000000000000000000000000001"},
+		{New(1, 20), "100000000000000000000"},
+		{New(999999999999999, 0), "999999999999999"},
+		{New(9999999999999999, -3), `="9999999999999.999"`},
+		{newDecimal.RequireFromString("12345678901234567890"), `="12345678901234567890"`},
+		{Number{}, "0"},
+	}
+
+	for _, test := range tests {
+		actual := StringExcelSafe(test.n)
+		assert.Equal(t, test.expected, actual)
+		parsed, err := ParseExcel(actual)
+		assert.NoError(t, err, actual)
+		assert.True(t, parsed.Equal(test.n), actual)
+	}
+}
+
+func TestIsExcelExact(t *testing.T) {
+	assert.True(t, IsExcelExact(New(123456789012345, -5)))
+	assert.True(t, IsExcelExact(New(1234567890123450, -5)))
+	assert.False(t, IsExcelExact(New(1234567890123456, -5)))
+	assert.True(t, IsExcelExact(Number{}))
+}
+
+func TestParseExcel(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Number
+	}{
+		{"12.34", New(1234, -2)},
+		{"\u00a012.34 \t", New(1234, -2)},
+		{"'12.34", New(1234, -2)},
+		{"12.34'", New(1234, -2)},
+		{"'0012.30'", New(1230, -2)},
+		{`="12345678901234567890"`, newDecimal.RequireFromString("12345678901234567890")},
+		{"(12.34)", New(-1234, -2)},
+		{"12,5", New(125, -1)},
+		{"1,23E+5", New(123000, 0)},
+		{"-1,5e-3", New(-15, -4)},
+		{"1 234,56", New(123456, -2)},
+		{"1\u00a0234\u00a0567", New(1234567, 0)},
+		{"\u00a0-1\u202f234,5\u00a0", New(-12345, -1)},
+		{"1.234.567,89", New(123456789, -2)},
+		{"1,234,567.89", New(123456789, -2)},
+		{"1,234,567", New(1234567, 0)},
+		{"1.234.567", New(1234567, 0)},
+		{"1E5", New(1, 5)},
+		{"0,125", New(125, -3)},
+		{"1234,567", New(1234567, -3)},
+		{"1 234,567", New(1234567, -3)},
+		{"1,2345", New(12345, -4)},
+		{"1,234E3", New(1234, 0)},
+	}
+
+	for _, test := range tests {
+		actual, err := ParseExcel(test.input)
+		assert.NoError(t, err, test.input)
+		assert.True(t, test.expected.Equal(actual), "%q: %s", test.input, actual)
+	}
+
+	for _, input := range []string{"", "'", "abc", "1,2,3.4.5", "=12", "(1", "1,234", "-12,500", "(999,000)"} {
+		_, err := ParseExcel(input)
+		assert.ErrorIs(t, err, ErrParse, input)
+		var perr *ParseError
+		if assert.ErrorAs(t, err, &perr) {
+			assert.Equal(t, input, perr.Input)
+		}
+	}
+	_, err := ParseExcel("1,234")
+	assert.EqualError(t, err, `decimal: can't parse "1,234": comma might be a decimal comma or digit grouping`)
+}
@@ -0,0 +1,29 @@
+// Package excelize writes and reads decimal numbers in spreadsheet cells using
+// github.com/xuri/excelize/v2 without float64 conversion.
+package excelize
+
+import (
+	"github.com/advbet/decimal/v2"
+	"github.com/xuri/excelize/v2"
+)
+
+// SetCellNumber writes n to a cell. Numbers exact in spreadsheets, see
+// decimal.IsExcelExact, are written as numeric cells holding the exact
+// decimal string. Longer numbers are written as text cells, so no digits are
+// lost.
+func SetCellNumber(f *excelize.File, sheet, cell string, n decimal.Number) error {
+	if decimal.IsExcelExact(n) {
+		return f.SetCellDefault(sheet, cell, decimal.String(n))
+	}
+	return f.SetCellStr(sheet, cell, decimal.String(n))
+}
+
+// GetCellNumber reads a decimal number from a cell. Raw cell value is used
+// regardless of the cell number format and parsed with decimal.ParseExcel.
+func GetCellNumber(f *excelize.File, sheet, cell string) (decimal.Number, error) {
+	str, err := f.GetCellValue(sheet, cell, excelize.Options{RawCellValue: true})
+	if err != nil {
+		return decimal.Number{}, err
+	}
+	return decimal.ParseExcel(str)
+}
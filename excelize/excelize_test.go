@@ -0,0 +1,57 @@
+package excelize
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestCellNumber(t *testing.T) {
+	numbers := []decimal.Number{
+		decimal.New(1234, -2),
+		decimal.New(-1, -30),
+		decimal.New(999999999999999, -2),
+		decimal.New(1234567890123456789, -10),
+		decimal.Zero(),
+	}
+
+	f := excelize.NewFile()
+	for i, n := range numbers {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		assert.NoError(t, err)
+		assert.NoError(t, SetCellNumber(f, "Sheet1", cell, n))
+	}
+	var buf bytes.Buffer
+	assert.NoError(t, f.Write(&buf))
+
+	f, err := excelize.OpenReader(&buf)
+	assert.NoError(t, err)
+	for i, n := range numbers {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		assert.NoError(t, err)
+		actual, err := GetCellNumber(f, "Sheet1", cell)
+		assert.NoError(t, err, cell)
+		assert.True(t, n.Equal(actual), "%s: %s != %s", cell, n, actual)
+
+		typ, err := f.GetCellType("Sheet1", cell)
+		assert.NoError(t, err)
+		if decimal.IsExcelExact(n) {
+			assert.NotEqual(t, excelize.CellTypeSharedString, typ, cell)
+		} else {
+			assert.Equal(t, excelize.CellTypeSharedString, typ, cell)
+		}
+	}
+
+	assert.NoError(t, f.SetCellStr("Sheet1", "B1", "1,25'"))
+	actual, err := GetCellNumber(f, "Sheet1", "B1")
+	assert.NoError(t, err)
+	assert.Equal(t, decimal.New(125, -2), actual)
+
+	_, err = GetCellNumber(f, "Sheet1", "B2")
+	assert.ErrorIs(t, err, decimal.ErrParse)
+	_, err = GetCellNumber(f, "Missing", "A1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,35 @@
+package decimal
+
+// Exposure holds gross and net exposure of a group of positions.
+type Exposure struct {
+	Gross Number // Gross is the exact sum of position magnitudes
+	Net   Number // Net is the exact sum of positions, long minus short
+}
+
+// NetExposure calculates gross and net exposure of long (positive) and short
+// (negative) positions. Both sums are exact, fully offsetting positions net
+// to zero. Exposure of no positions is zero.
+func NetExposure(positions []Number) (gross, net Number) {
+	abs := make([]Number, len(positions))
+	for i, p := range positions {
+		abs[i] = p.Abs()
+	}
+	return sumExact(abs), sumExact(positions)
+}
+
+// NetExposureBy groups items by key and calculates exposure of positions in
+// every group, see NetExposure.
+func NetExposureBy[T any, K comparable](items []T, key func(T) K, position func(T) Number) map[K]Exposure {
+	groups := make(map[K][]Number)
+	for _, item := range items {
+		k := key(item)
+		groups[k] = append(groups[k], position(item))
+	}
+
+	res := make(map[K]Exposure, len(groups))
+	for k, positions := range groups {
+		gross, net := NetExposure(positions)
+		res[k] = Exposure{Gross: gross, Net: net}
+	}
+	return res
+}
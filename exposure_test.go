@@ -0,0 +1,53 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetExposure(t *testing.T) {
+	tests := []struct {
+		positions  []Number
+		gross, net string
+	}{
+		{nil, "0", "0"},
+		{numbers("100", "-40.5", "15.25"), "155.75", "74.75"},
+		{numbers("0.1", "0.2", "-0.3"), "0.6", "0"},
+		{numbers("-10.00", "-2.5"), "12.5", "-12.5"},
+	}
+
+	for _, test := range tests {
+		gross, net := NetExposure(test.positions)
+		assert.Equal(t, test.gross, gross.String(), "%v", numberStrings(test.positions))
+		assert.Equal(t, test.net, net.String(), "%v", numberStrings(test.positions))
+	}
+
+	// offsetting positions net to zero, not a negative zero
+	_, net := NetExposure(numbers("-0.10", "0.1"))
+	assert.Equal(t, 0, net.Sign())
+	assert.Equal(t, "0.00", net.StringFixed(2))
+}
+
+func TestNetExposureBy(t *testing.T) {
+	type bet struct {
+		selection string
+		liability Number
+	}
+	bets := []bet{
+		{"home", New(150, 0)},
+		{"away", New(-75, -1)},
+		{"home", New(-1505, -1)},
+		{"draw", New(20, 0)},
+		{"away", New(75, -1)},
+	}
+
+	actual := NetExposureBy(bets, func(b bet) string { return b.selection }, func(b bet) Number { return b.liability })
+	assert.Len(t, actual, 3)
+	assert.Equal(t, "300.5", actual["home"].Gross.String())
+	assert.Equal(t, "-0.5", actual["home"].Net.String())
+	assert.Equal(t, "15", actual["away"].Gross.String())
+	assert.Equal(t, "0.00", actual["away"].Net.StringFixed(2))
+	assert.Equal(t, "20", actual["draw"].Gross.String())
+	assert.Equal(t, "20", actual["draw"].Net.String())
+}
@@ -0,0 +1,296 @@
+package decimal
+
+import (
+	"database/sql/driver"
+	"strings"
+)
+
+type extKind uint8
+
+const (
+	extFinite extKind = iota
+	extNaN
+	extPosInf
+	extNegInf
+)
+
+// Extended is a decimal number that can also hold NaN and positive or negative
+// infinity, mirroring the PostgreSQL numeric type. Special values are opt-in:
+// they exist only in Extended values, Number and all functions operating on
+// it never produce or accept them. Zero value is finite zero.
+//
+// Comparison follows PostgreSQL: -Infinity is smaller and +Infinity is larger
+// than any finite number, NaN is equal to NaN and larger than any other value.
+// Arithmetic follows IEEE 754: NaN propagates, operations without meaningful
+// result (e.g. +Infinity + -Infinity or Infinity * 0) produce NaN.
+type Extended struct {
+	n    Number
+	kind extKind
+}
+
+// ExtendedFinite creates an extended value holding a finite number.
+func ExtendedFinite(n Number) Extended {
+	return Extended{n: n}
+}
+
+// NaN creates an extended value holding NaN.
+func NaN() Extended {
+	return Extended{kind: extNaN}
+}
+
+// Inf creates an extended value holding positive infinity if sign >= 0,
+// negative infinity if sign < 0.
+func Inf(sign int) Extended {
+	if sign < 0 {
+		return Extended{kind: extNegInf}
+	}
+	return Extended{kind: extPosInf}
+}
+
+// ParseExtended parses a decimal number or a special value. Special values
+// "NaN", "Infinity" and "Inf" with an optional sign for infinities are
+// accepted case-insensitively. Returned error is a *ParseError.
+func ParseExtended(str string) (Extended, error) {
+	switch strings.ToLower(str) {
+	case "nan":
+		return NaN(), nil
+	case "infinity", "+infinity", "inf", "+inf":
+		return Inf(1), nil
+	case "-infinity", "-inf":
+		return Inf(-1), nil
+	}
+
+	n, err := FromString(str)
+	if err != nil {
+		return Extended{}, err
+	}
+	return ExtendedFinite(n), nil
+}
+
+// IsNaN checks if value is NaN.
+func (e Extended) IsNaN() bool {
+	return e.kind == extNaN
+}
+
+// IsInf checks if value is an infinity according to sign. If sign > 0, IsInf
+// checks for positive infinity, if sign < 0 for negative infinity, if sign
+// == 0 for either infinity.
+func (e Extended) IsInf(sign int) bool {
+	return sign >= 0 && e.kind == extPosInf || sign <= 0 && e.kind == extNegInf
+}
+
+// IsFinite checks if value is neither NaN nor an infinity.
+func (e Extended) IsFinite() bool {
+	return e.kind == extFinite
+}
+
+// Finite returns the finite number or ErrNotFinite for special values.
+func (e Extended) Finite() (Number, error) {
+	if e.kind != extFinite {
+		return Number{}, ErrNotFinite
+	}
+	return e.n, nil
+}
+
+// Sign returns -1 for negative numbers and negative infinity, +1 for positive
+// numbers and positive infinity, 0 for zero and NaN.
+func (e Extended) Sign() int {
+	switch e.kind {
+	case extPosInf:
+		return 1
+	case extNegInf:
+		return -1
+	case extNaN:
+		return 0
+	default:
+		return e.n.Sign()
+	}
+}
+
+// Cmp compares values e and other and returns -1, 0 or +1, see Extended
+// documentation for the ordering of special values.
+func (e Extended) Cmp(other Extended) int {
+	if e.kind == extFinite && other.kind == extFinite {
+		return Cmp(e.n, other.n)
+	}
+	return cmpInt(e.rank(), other.rank())
+}
+
+// Equal checks if both values are equal according to Cmp, i.e. NaN is equal
+// to NaN.
+func (e Extended) Equal(other Extended) bool {
+	return e.Cmp(other) == 0
+}
+
+// rank orders value kinds, all finite values have the same rank.
+func (e Extended) rank() int {
+	switch e.kind {
+	case extNegInf:
+		return -1
+	case extPosInf:
+		return 1
+	case extNaN:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// Neg returns -e.
+func (e Extended) Neg() Extended {
+	switch e.kind {
+	case extPosInf:
+		return Inf(-1)
+	case extNegInf:
+		return Inf(1)
+	case extNaN:
+		return e
+	default:
+		return ExtendedFinite(e.n.Neg())
+	}
+}
+
+// Add returns e + other.
+func (e Extended) Add(other Extended) Extended {
+	switch {
+	case e.kind == extNaN || other.kind == extNaN:
+		return NaN()
+	case e.kind == extFinite && other.kind == extFinite:
+		return ExtendedFinite(e.n.Add(other.n))
+	case e.kind == extFinite:
+		return other
+	case other.kind == extFinite || e.kind == other.kind:
+		return e
+	default: // infinities of opposite signs
+		return NaN()
+	}
+}
+
+// Sub returns e - other.
+func (e Extended) Sub(other Extended) Extended {
+	return e.Add(other.Neg())
+}
+
+// Mul returns e * other.
+func (e Extended) Mul(other Extended) Extended {
+	switch {
+	case e.kind == extNaN || other.kind == extNaN:
+		return NaN()
+	case e.kind == extFinite && other.kind == extFinite:
+		return ExtendedFinite(e.n.Mul(other.n))
+	}
+
+	sign := e.Sign() * other.Sign()
+	if sign == 0 {
+		return NaN()
+	}
+	return Inf(sign)
+}
+
+// Div calculates e / other, finite quotient is rounded to the given exponent
+// using the given rounding rule. Division of a finite number or an infinity by
+// zero fails with ErrDivisionByZero, like it does in PostgreSQL. Dividing a
+// finite number by an infinity returns zero, dividing infinities returns NaN.
+func (e Extended) Div(other Extended, exp int, rule RoundRule) (Extended, error) {
+	switch {
+	case e.kind == extNaN || other.kind == extNaN:
+		return NaN(), nil
+	case other.kind == extFinite && other.n.IsZero():
+		return Extended{}, ErrDivisionByZero
+	case e.kind == extFinite && other.kind == extFinite:
+		return ExtendedFinite(divRound(e.n, other.n, exp, rule)), nil
+	case e.kind == extFinite:
+		return ExtendedFinite(Round(Zero(), exp, rule)), nil
+	case other.kind == extFinite:
+		return Inf(e.Sign() * other.Sign()), nil
+	default:
+		return NaN(), nil
+	}
+}
+
+// String returns string representation of the value, special values are
+// formatted as "NaN", "Infinity" and "-Infinity".
+func (e Extended) String() string {
+	switch e.kind {
+	case extNaN:
+		return "NaN"
+	case extPosInf:
+		return "Infinity"
+	case extNegInf:
+		return "-Infinity"
+	default:
+		return String(e.n)
+	}
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e Extended) MarshalText() ([]byte, error) {
+	if e.kind != extFinite {
+		return []byte(e.String()), nil
+	}
+	return AppendText(make([]byte, 0, appendBufSize), e.n), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *Extended) UnmarshalText(text []byte) error {
+	v, err := ParseExtended(string(text))
+	if err != nil {
+		return err
+	}
+	if err := checkBounds(v.n); err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. JSON has no special
+// number values, so they are always marshaled as JSON strings.
+func (e Extended) MarshalJSON() ([]byte, error) {
+	if e.kind != extFinite {
+		return []byte(`"` + e.String() + `"`), nil
+	}
+	return AppendJSON(make([]byte, 0, appendBufSize), e.n), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Both JSON numbers
+// and JSON strings are accepted, JSON null is a no-op.
+func (e *Extended) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) > 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return e.UnmarshalText(data)
+}
+
+// Scan implements the sql.Scanner interface for database deserialization.
+// Integers of any size, e.g. uint64 or *big.Int, are converted exactly.
+func (e *Extended) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		return e.UnmarshalText([]byte(v))
+	case []byte:
+		return e.UnmarshalText(v)
+	default:
+		n, err := scanValue(value)
+		if err != nil {
+			return err
+		}
+		if err := checkBounds(n); err != nil {
+			return err
+		}
+		*e = ExtendedFinite(n)
+		return nil
+	}
+}
+
+// Value implements the driver.Valuer interface for database serialization.
+// Special values are stored using PostgreSQL text representation.
+func (e Extended) Value() (driver.Value, error) {
+	if e.kind != extFinite {
+		return e.String(), nil
+	}
+	return e.n.Value()
+}
@@ -0,0 +1,163 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseExtended(t *testing.T) {
+	tests := []struct {
+		str      string
+		expected Extended
+	}{
+		{"NaN", NaN()},
+		{"nan", NaN()},
+		{"Infinity", Inf(1)},
+		{"+inf", Inf(1)},
+		{"-Infinity", Inf(-1)},
+		{"-INF", Inf(-1)},
+		{"-1.50", ExtendedFinite(New(-150, -2))},
+	}
+
+	for _, test := range tests {
+		actual, err := ParseExtended(test.str)
+		assert.NoError(t, err, test.str)
+		assert.Equal(t, test.expected, actual, test.str)
+	}
+
+	_, err := ParseExtended("-NaN")
+	assert.ErrorIs(t, err, ErrParse)
+}
+
+func TestExtendedPredicates(t *testing.T) {
+	assert.True(t, NaN().IsNaN())
+	assert.False(t, NaN().IsInf(0))
+	assert.False(t, NaN().IsFinite())
+	assert.True(t, Inf(1).IsInf(1))
+	assert.True(t, Inf(1).IsInf(0))
+	assert.False(t, Inf(1).IsInf(-1))
+	assert.True(t, Inf(-1).IsInf(-1))
+	assert.True(t, Extended{}.IsFinite())
+
+	_, err := Inf(1).Finite()
+	assert.ErrorIs(t, err, ErrNotFinite)
+	n, err := ExtendedFinite(New(5, 0)).Finite()
+	assert.NoError(t, err)
+	assert.Equal(t, New(5, 0), n)
+}
+
+func TestExtendedCmp(t *testing.T) {
+	ordered := []Extended{
+		Inf(-1),
+		ExtendedFinite(New(-1, 10)),
+		ExtendedFinite(Zero()),
+		ExtendedFinite(New(1, -10)),
+		Inf(1),
+		NaN(),
+	}
+
+	for i := range ordered {
+		for j := range ordered {
+			assert.Equal(t, cmpInt(i, j), ordered[i].Cmp(ordered[j]), "%s cmp %s", ordered[i], ordered[j])
+		}
+	}
+	assert.True(t, NaN().Equal(NaN()))
+	assert.True(t, ExtendedFinite(New(10, -1)).Equal(ExtendedFinite(One)))
+}
+
+func TestExtendedArithmetic(t *testing.T) {
+	one := ExtendedFinite(One)
+	zero := ExtendedFinite(Zero())
+	tests := []struct {
+		name     string
+		actual   Extended
+		expected Extended
+	}{
+		{"1+2", one.Add(ExtendedFinite(Two)), ExtendedFinite(New(3, 0))},
+		{"1+inf", one.Add(Inf(1)), Inf(1)},
+		{"-inf+1", Inf(-1).Add(one), Inf(-1)},
+		{"inf+inf", Inf(1).Add(Inf(1)), Inf(1)},
+		{"inf+-inf", Inf(1).Add(Inf(-1)), NaN()},
+		{"nan+1", NaN().Add(one), NaN()},
+		{"inf-inf", Inf(1).Sub(Inf(1)), NaN()},
+		{"1-inf", one.Sub(Inf(1)), Inf(-1)},
+		{"2*3", ExtendedFinite(Two).Mul(ExtendedFinite(New(3, 0))), ExtendedFinite(New(6, 0))},
+		{"-1*inf", one.Neg().Mul(Inf(1)), Inf(-1)},
+		{"-inf*-inf", Inf(-1).Mul(Inf(-1)), Inf(1)},
+		{"0*inf", zero.Mul(Inf(1)), NaN()},
+		{"nan*0", NaN().Mul(zero), NaN()},
+		{"-nan", NaN().Neg(), NaN()},
+		{"-inf", Inf(1).Neg(), Inf(-1)},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.actual, test.name)
+	}
+}
+
+func TestExtendedDiv(t *testing.T) {
+	tests := []struct {
+		x, y     Extended
+		expected Extended
+	}{
+		{ExtendedFinite(Two), ExtendedFinite(New(3, 0)), ExtendedFinite(New(67, -2))},
+		{ExtendedFinite(Two), Inf(-1), ExtendedFinite(New(0, -2))},
+		{Inf(1), ExtendedFinite(New(-3, 0)), Inf(-1)},
+		{Inf(1), Inf(1), NaN()},
+		{NaN(), ExtendedFinite(Zero()), NaN()},
+	}
+
+	for _, test := range tests {
+		actual, err := test.x.Div(test.y, -2, RoundBankers)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, actual, "%s / %s", test.x, test.y)
+	}
+
+	_, err := Inf(1).Div(ExtendedFinite(Zero()), -2, RoundBankers)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestExtendedMarshal(t *testing.T) {
+	values := []Extended{NaN(), Inf(1), Inf(-1), ExtendedFinite(New(-125, -2))}
+	data, err := json.Marshal(values)
+	assert.NoError(t, err)
+	assert.Equal(t, `["NaN","Infinity","-Infinity",-1.25]`, string(data))
+
+	var decoded []Extended
+	assert.NoError(t, json.Unmarshal([]byte(`["NaN","Infinity","-Infinity",-1.25,"-1.25"]`), &decoded))
+	assert.Equal(t, append(values, ExtendedFinite(New(-125, -2))), decoded)
+
+	// null leaves the value unchanged
+	e := Inf(1)
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &e))
+	assert.Equal(t, Inf(1), e)
+	var pair struct{ A, B Extended }
+	pair.B = NaN()
+	assert.NoError(t, json.Unmarshal([]byte(`{"A":"1.5","B":null}`), &pair))
+	assert.Equal(t, ExtendedFinite(New(15, -1)), pair.A)
+	assert.True(t, pair.B.IsNaN())
+	assert.Error(t, json.Unmarshal([]byte(`"null"`), &e))
+
+	text, err := Inf(-1).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "-Infinity", string(text))
+}
+
+func TestExtendedScanValue(t *testing.T) {
+	var e Extended
+	assert.NoError(t, e.Scan([]byte("NaN")))
+	assert.True(t, e.IsNaN())
+	assert.NoError(t, e.Scan("-Infinity"))
+	assert.True(t, e.IsInf(-1))
+	assert.NoError(t, e.Scan(int64(7)))
+	assert.Equal(t, ExtendedFinite(New(7, 0)), e)
+
+	v, err := Inf(1).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "Infinity", v)
+	v, err = ExtendedFinite(New(15, -1)).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "1.5", v)
+}
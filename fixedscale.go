@@ -0,0 +1,165 @@
+package decimal
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// FixedScale is a decimal number that always has the same exponent. The
+// exponent is set on construction and preserved by every operation,
+// operations that would need rounding fail with ErrPrecisionLoss unless
+// rounding is requested explicitly. Zero value is zero with exponent 0.
+//
+// Decoders keep the exponent of the destination, so fields must be created
+// with NewFixedScale before decoding into them, e.g. zero with exponent -2.
+// Decoded numbers that do not fit the exponent without rounding fail with
+// ErrPrecisionLoss, numbers exceeding the process-wide DecodeOptions bounds
+// fail with ErrOutOfBounds. On failure the value is left unchanged.
+type FixedScale struct {
+	n Number
+}
+
+// NewFixedScale creates fixed scale number with the given exponent. It fails
+// with ErrPrecisionLoss if n can not be represented with the given exponent
+// without rounding.
+func NewFixedScale(n Number, exp int) (FixedScale, error) {
+	scaled, err := rescaleExact(n, exp)
+	if err != nil {
+		return FixedScale{}, err
+	}
+	return FixedScale{n: scaled}, nil
+}
+
+// RoundFixedScale creates fixed scale number by rounding n to the given
+// exponent using the given rounding rule.
+func RoundFixedScale(n Number, exp int, rule RoundRule) FixedScale {
+	return FixedScale{n: Round(n, exp, rule)}
+}
+
+// Number returns the decimal number, its exponent is always equal to Exp.
+func (f FixedScale) Number() Number {
+	return f.n
+}
+
+// Exp returns the fixed exponent of the number.
+func (f FixedScale) Exp() int {
+	return int(f.n.Exponent())
+}
+
+// Add calculates f + n. It fails with ErrPrecisionLoss if the sum has more
+// decimal places than the fixed exponent allows.
+func (f FixedScale) Add(n Number) (FixedScale, error) {
+	return NewFixedScale(f.n.Add(n), f.Exp())
+}
+
+// Sub calculates f - n. It fails with ErrPrecisionLoss if the difference has
+// more decimal places than the fixed exponent allows.
+func (f FixedScale) Sub(n Number) (FixedScale, error) {
+	return NewFixedScale(f.n.Sub(n), f.Exp())
+}
+
+// Mul calculates f * n. It fails with ErrPrecisionLoss if the product has
+// more decimal places than the fixed exponent allows.
+func (f FixedScale) Mul(n Number) (FixedScale, error) {
+	return NewFixedScale(f.n.Mul(n), f.Exp())
+}
+
+// MulRound calculates f * n and rounds the product to the fixed exponent
+// using the given rounding rule.
+func (f FixedScale) MulRound(n Number, rule RoundRule) FixedScale {
+	return RoundFixedScale(f.n.Mul(n), f.Exp(), rule)
+}
+
+// MulInt calculates f * n, integer multiplication never needs rounding.
+func (f FixedScale) MulInt(n int) FixedScale {
+	return FixedScale{n: MulInt(f.n, n)}
+}
+
+// Neg returns -f.
+func (f FixedScale) Neg() FixedScale {
+	return FixedScale{n: f.n.Neg()}
+}
+
+// String returns string representation with exactly as many decimal places
+// as the fixed exponent defines.
+func (f FixedScale) String() string {
+	return f.n.StringFixed(-f.n.Exponent())
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f FixedScale) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (f *FixedScale) UnmarshalText(text []byte) error {
+	n, err := FromString(string(text))
+	if err != nil {
+		return err
+	}
+	if err := checkBounds(n); err != nil {
+		return err
+	}
+	return f.set(n)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (f FixedScale) MarshalJSON() ([]byte, error) {
+	if newDecimal.MarshalJSONWithoutQuotes {
+		return []byte(f.String()), nil
+	}
+	return []byte(`"` + f.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. JSON null is a
+// no-op.
+func (f *FixedScale) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var n Number
+	if err := unmarshalJSON(&n, data); err != nil {
+		return err
+	}
+	return f.set(n)
+}
+
+// Value implements the driver.Valuer interface for database serialization.
+func (f FixedScale) Value() (driver.Value, error) {
+	return f.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for database deserialization.
+// Integers of any size, e.g. uint64 or *big.Int, are converted exactly.
+func (f *FixedScale) Scan(value interface{}) error {
+	n, err := scanValue(value)
+	if err != nil {
+		return err
+	}
+	if err := checkBounds(n); err != nil {
+		return err
+	}
+	return f.set(n)
+}
+
+// set assigns decoded number n rescaled to the fixed exponent of f.
+func (f *FixedScale) set(n Number) error {
+	scaled, err := rescaleExact(n, f.Exp())
+	if err != nil {
+		return fmt.Errorf("%w: %s has more decimal places than exponent %d allows", err, DebugString(n), f.Exp())
+	}
+	f.n = scaled
+	return nil
+}
+
+// rescaleExact changes exponent of n without rounding. It fails with
+// ErrPrecisionLoss if rounding would be needed.
+func rescaleExact(n Number, exp int) (Number, error) {
+	scaled := Rescale(n, int32(exp))
+	if !scaled.Equal(n) {
+		return Number{}, ErrPrecisionLoss
+	}
+	return scaled, nil
+}
@@ -0,0 +1,129 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFixedScale(t *testing.T) {
+	f, err := NewFixedScale(New(15, -1), -2)
+	assert.NoError(t, err)
+	assert.Equal(t, newDecimal.New(150, -2), f.Number())
+	assert.Equal(t, -2, f.Exp())
+	assert.Equal(t, "1.50", f.String())
+
+	f, err = NewFixedScale(New(1500, -3), -2)
+	assert.NoError(t, err)
+	assert.Equal(t, newDecimal.New(150, -2), f.Number())
+
+	_, err = NewFixedScale(New(1501, -3), -2)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	f = RoundFixedScale(New(1505, -3), -2, RoundBankers)
+	assert.Equal(t, newDecimal.New(150, -2), f.Number())
+
+	var zero FixedScale
+	assert.Equal(t, 0, zero.Exp())
+	assert.Equal(t, "0", zero.String())
+}
+
+func TestFixedScaleOperations(t *testing.T) {
+	f, err := NewFixedScale(New(10, 0), -2)
+	assert.NoError(t, err)
+
+	sum, err := f.Add(New(5, -1))
+	assert.NoError(t, err)
+	assert.Equal(t, newDecimal.New(1050, -2), sum.Number())
+
+	_, err = f.Add(New(5, -3))
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	diff, err := f.Sub(New(25, -2))
+	assert.NoError(t, err)
+	assert.Equal(t, newDecimal.New(975, -2), diff.Number())
+
+	prod, err := f.Mul(New(15, -1))
+	assert.NoError(t, err)
+	assert.Equal(t, newDecimal.New(1500, -2), prod.Number())
+
+	_, err = f.Mul(New(1, -4))
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	assert.Equal(t, newDecimal.New(1, -2), f.MulRound(New(5, -4), RoundMath).Number())
+	assert.Equal(t, "0.00", f.MulRound(New(5, -4), RoundBankers).String())
+	assert.Equal(t, newDecimal.New(3000, -2), f.MulInt(3).Number())
+	assert.Equal(t, newDecimal.New(-1000, -2), f.Neg().Number())
+}
+
+func TestFixedScaleMarshal(t *testing.T) {
+	f, err := NewFixedScale(New(5, 0), -2)
+	assert.NoError(t, err)
+
+	blob, err := json.Marshal(f)
+	assert.NoError(t, err)
+	assert.Equal(t, `5.00`, string(blob))
+
+	text, err := f.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("5.00"), text)
+
+	val, err := f.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "5.00", val)
+}
+
+func TestFixedScaleUnmarshal(t *testing.T) {
+	f, err := NewFixedScale(Zero(), -2)
+	assert.NoError(t, err)
+
+	assert.NoError(t, f.UnmarshalText([]byte("1.5")))
+	assert.Equal(t, newDecimal.New(150, -2), f.Number())
+	assert.NoError(t, json.Unmarshal([]byte(`"2.250"`), &f))
+	assert.Equal(t, newDecimal.New(225, -2), f.Number())
+	assert.NoError(t, json.Unmarshal([]byte(`3`), &f))
+	assert.Equal(t, "3.00", f.String())
+	assert.NoError(t, f.Scan([]byte("-4.1")))
+	assert.Equal(t, newDecimal.New(-410, -2), f.Number())
+	assert.NoError(t, f.Scan(int64(7)))
+	assert.Equal(t, "7.00", f.String())
+
+	// failures and null leave the value unchanged
+	err = f.UnmarshalText([]byte("1.234"))
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	assert.EqualError(t, err, `decimal: precision loss: dec(coef=1234, exp=-3, "1.234") has more decimal places than exponent -2 allows`)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`0.001`), &f), ErrPrecisionLoss)
+	assert.ErrorIs(t, f.Scan("5.555"), ErrPrecisionLoss)
+	assert.Error(t, f.UnmarshalText([]byte("x")))
+	assert.Error(t, f.Scan(nil))
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &f))
+	assert.Equal(t, "7.00", f.String())
+
+	var whole FixedScale
+	assert.NoError(t, json.Unmarshal([]byte(`12`), &whole))
+	assert.Equal(t, 0, whole.Exp())
+	assert.ErrorIs(t, json.Unmarshal([]byte(`12.5`), &whole), ErrPrecisionLoss)
+
+	type order struct {
+		Price FixedScale `json:"price"`
+	}
+	o := order{Price: f}
+	assert.NoError(t, json.Unmarshal([]byte(`{"price":"9.9"}`), &o))
+	assert.Equal(t, "9.90", o.Price.String())
+}
+
+func TestFixedScaleUnmarshalBounds(t *testing.T) {
+	defer SetDecodeOptions(CurrentDecodeOptions())
+	SetDecodeOptions(DecodeOptions{Bounds: Bounds{MaxCoefficientDigits: 4}})
+
+	f, err := NewFixedScale(Zero(), -2)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, f.UnmarshalText([]byte("12345")), ErrOutOfBounds)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`12345`), &f), ErrOutOfBounds)
+	assert.ErrorIs(t, f.Scan(int64(12345)), ErrOutOfBounds)
+	// bounds apply to the decoded number, not to the rescaled one
+	assert.NoError(t, f.UnmarshalText([]byte("999.5")))
+	assert.Equal(t, "999.50", f.String())
+}
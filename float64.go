@@ -1,6 +1,8 @@
 package decimal
 
 import (
+	"strconv"
+
 	newDecimal "github.com/shopspring/decimal"
 )
 
@@ -8,3 +10,24 @@ import (
 func FromFloat64(f float64) (Number, error) {
 	return newDecimal.NewFromFloat(f), nil
 }
+
+// IsFloat64Exact reports if n survives a round trip through float64, i.e.
+// the nearest float64 formatted with the shortest representation is equal to
+// n. Numbers having more than 17 significant digits are never exact, numbers
+// having up to 15 significant digits are exact unless they are outside of the
+// float64 range. Trailing zeros of the coefficient are not significant.
+func IsFloat64Exact(n Number) bool {
+	digits, exp := SignificantDigits(n)
+	if digits == 0 {
+		return true
+	}
+	// 10^(mag-1) <= |n| < 10^mag, float64 range is about 4.9e-324..1.8e308
+	if mag := digits + exp; digits > 17 || mag > 309 || mag < -323 {
+		return false
+	}
+	f, err := strconv.ParseFloat(Key(n), 64)
+	if err != nil {
+		return false
+	}
+	return newDecimal.NewFromFloat(f).Equal(n)
+}
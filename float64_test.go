@@ -64,3 +64,30 @@ func TestFromFloat64(t *testing.T) {
 		assert.Equal(t, test.n, n, fmt.Sprintf("FromFloat(%g), expected %s", test.f, test.n))
 	}
 }
+
+func TestIsFloat64Exact(t *testing.T) {
+	tests := []struct {
+		n     string
+		exact bool
+	}{
+		{"0", true},
+		{"0.1", true},
+		{"-12.500", true},
+		{"123456789012345", true},
+		{"0.30000000000000004", true},
+		{"9007199254740992", true},
+		{"9007199254740993", false},
+		{"0.1000000000000000055511151231257827", false},
+		{"12345678901234567890", false},
+		{"1e308", true},
+		{"1e309", false},
+		{"5e-324", true},
+		{"1e-400", false},
+		{"1e2147483647", false},
+		{"-1e-2147483647", false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.exact, IsFloat64Exact(newDecimal.RequireFromString(test.n)), test.n)
+	}
+}
@@ -0,0 +1,43 @@
+package decimal
+
+// IsInteger checks if n has no fractional part, e.g. 12 and 12.00 are
+// integers but 12.5 is not. Uninitialized Number{} is treated as zero. Non-zero
+// numbers below one are detected without rescaling, so tiny numbers such as
+// 1e-2147483648 decoded from untrusted input are checked cheaply.
+func IsInteger(n Number) bool {
+	n = ensureInitialized(n)
+	if belowOne(n) {
+		return false
+	}
+	return n.IsInteger()
+}
+
+// HasFraction checks if n has a non-zero fractional part, it is the opposite
+// of IsInteger.
+func HasFraction(n Number) bool {
+	return !IsInteger(n)
+}
+
+// FractionalPart returns fractional part of n having the same sign and
+// exponent as n, e.g. 12.34 gives 0.34 and -12.34 gives -0.34. The result is
+// zero for integers. Uninitialized Number{} is treated as zero. Like
+// IsInteger, it returns numbers below one without rescaling them.
+func FractionalPart(n Number) Number {
+	n = ensureInitialized(n)
+	if n.Exponent() >= 0 {
+		return Zero()
+	}
+	if belowOne(n) {
+		return n
+	}
+	return n.Sub(Rescale(n, 0))
+}
+
+// belowOne checks cheaply if n is non-zero and its magnitude is less than one
+// without rescaling the coefficient, which is expensive for numbers with
+// extreme exponents. A false result does not imply |n| >= 1.
+func belowOne(n Number) bool {
+	// |coef| < 2^bits < 10^bits <= 10^-exp
+	bits := n.Coefficient().BitLen()
+	return bits > 0 && -int64(n.Exponent()) >= int64(bits)
+}
@@ -0,0 +1,66 @@
+package decimal
+
+import (
+	"math"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsInteger(t *testing.T) {
+	tests := []struct {
+		n        string
+		integer  bool
+		fraction string
+	}{
+		{"0", true, "0"},
+		{"0.00", true, "0"},
+		{"12", true, "0"},
+		{"12.00", true, "0"},
+		{"-12.000", true, "0"},
+		{"1e3", true, "0"},
+		{"12.5", false, "0.5"},
+		{"12.34", false, "0.34"},
+		{"-12.34", false, "-0.34"},
+		{"0.001", false, "0.001"},
+		{"-0.5", false, "-0.5"},
+		{"123456789012345678901234567890.1", false, "0.1"},
+	}
+
+	for _, test := range tests {
+		n := newDecimal.RequireFromString(test.n)
+		assert.Equal(t, test.integer, IsInteger(n), test.n)
+		assert.Equal(t, !test.integer, HasFraction(n), test.n)
+		f := FractionalPart(n)
+		assert.Equal(t, test.fraction, f.String(), test.n)
+		if n.Exponent() < 0 {
+			assert.Equal(t, n.Exponent(), f.Exponent(), test.n)
+		}
+		assert.True(t, Rescale(n, 0).Add(f).Equal(n), test.n)
+	}
+
+	assert.True(t, IsInteger(Number{}))
+	assert.False(t, HasFraction(Number{}))
+	assert.Equal(t, newDecimal.New(0, 0), FractionalPart(Number{}))
+}
+
+func TestIsIntegerExtremeExponents(t *testing.T) {
+	// rescaling these numbers to exponent 0 would need gigabytes of memory
+	for _, n := range []Number{
+		New(1, math.MinInt32),
+		New(-15, math.MinInt32),
+		New(999, -2147483645),
+		newDecimal.NewFromBigInt(pow10(40), -100000000),
+	} {
+		assert.False(t, IsInteger(n), DebugString(n))
+		assert.True(t, HasFraction(n), DebugString(n))
+		assert.Equal(t, n, FractionalPart(n), DebugString(n))
+	}
+
+	// 10^-5 with a 133-bit coefficient is missed by the bit length estimate
+	n := newDecimal.NewFromBigInt(pow10(40), -45)
+	assert.False(t, IsInteger(n))
+	assert.Equal(t, "0.00001", FractionalPart(n).String())
+	assert.True(t, IsInteger(New(1, math.MaxInt32)))
+}
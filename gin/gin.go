@@ -0,0 +1,94 @@
+// Package gin implements github.com/gin-gonic/gin bindings decoding form and
+// query parameters into decimal Number, NullNumber and Optional fields, e.g.
+//
+//	var q struct {
+//		MinOdds decimal.Number `form:"min_odds"`
+//	}
+//	err := c.ShouldBindWith(&q, gin.Query)
+//
+// Default gin bindings decode decimal fields as JSON, they reject empty
+// values and accept quoted ones. Parameters are decoded with
+// github.com/gorilla/schema using the form tag and validated with
+// binding.Validator like default gin bindings.
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/advbet/decimal/v2"
+	"github.com/advbet/decimal/v2/schema"
+	"github.com/gin-gonic/gin/binding"
+	gschema "github.com/gorilla/schema"
+)
+
+// maxMemory is the multipart form memory limit used by gin.
+const maxMemory = 32 << 20
+
+// Bindings decoding parameters with the package-level parsing behaviour.
+var (
+	// Query decodes URL query parameters.
+	Query binding.Binding = NewQuery(decimal.DefaultConfig())
+	// Form decodes URL query parameters and url-encoded or multipart form
+	// bodies.
+	Form binding.Binding = NewForm(decimal.DefaultConfig())
+)
+
+// NewQuery returns a binding decoding URL query parameters with c.Parse, so
+// Strict and bounds of c apply.
+func NewQuery(c decimal.Config) binding.Binding {
+	return queryBinding{decoder: newDecoder(c)}
+}
+
+// NewForm returns a binding decoding URL query parameters and url-encoded or
+// multipart form bodies with c.Parse, so Strict and bounds of c apply.
+func NewForm(c decimal.Config) binding.Binding {
+	return formBinding{decoder: newDecoder(c)}
+}
+
+type queryBinding struct {
+	decoder *gschema.Decoder
+}
+
+func (queryBinding) Name() string {
+	return "query"
+}
+
+func (b queryBinding) Bind(req *http.Request, obj interface{}) error {
+	return bind(b.decoder, req.URL.Query(), obj)
+}
+
+type formBinding struct {
+	decoder *gschema.Decoder
+}
+
+func (formBinding) Name() string {
+	return "form"
+}
+
+func (b formBinding) Bind(req *http.Request, obj interface{}) error {
+	if err := req.ParseMultipartForm(maxMemory); err != nil && !errors.Is(err, http.ErrNotMultipart) {
+		return err
+	}
+	return bind(b.decoder, req.Form, obj)
+}
+
+// newDecoder returns a decoder matching gin form binding conventions.
+func newDecoder(c decimal.Config) *gschema.Decoder {
+	d := gschema.NewDecoder()
+	d.SetAliasTag("form")
+	d.IgnoreUnknownKeys(true)
+	schema.RegisterConfig(d, c)
+	return d
+}
+
+func bind(d *gschema.Decoder, values url.Values, obj interface{}) error {
+	if err := d.Decode(obj, values); err != nil {
+		return err
+	}
+	if binding.Validator == nil {
+		return nil
+	}
+	return binding.Validator.ValidateStruct(obj)
+}
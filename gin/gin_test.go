@@ -0,0 +1,74 @@
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	ugin "github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type query struct {
+	MinOdds decimal.Number     `form:"min_odds" binding:"required"`
+	MaxOdds decimal.NullNumber `form:"max_odds"`
+	Stake   decimal.Optional   `form:"stake"`
+	Sport   string             `form:"sport"`
+}
+
+func context(req *http.Request) *ugin.Context {
+	ugin.SetMode(ugin.TestMode)
+	c, _ := ugin.CreateTestContext(httptest.NewRecorder())
+	c.Request = req
+	return c
+}
+
+func TestQuery(t *testing.T) {
+	c := context(httptest.NewRequest(http.MethodGet, "/?min_odds=1.50&max_odds=&stake=10&sport=tennis&page=2", nil))
+
+	var actual query
+	assert.NoError(t, c.ShouldBindWith(&actual, Query))
+	assert.Equal(t, query{
+		MinOdds: decimal.New(150, -2),
+		Stake:   decimal.OptionalValue(decimal.New(10, 0)),
+		Sport:   "tennis",
+	}, actual)
+	assert.Equal(t, "query", Query.Name())
+}
+
+func TestQueryError(t *testing.T) {
+	for _, u := range []string{"/?min_odds=x", `/?min_odds="1"`, "/?min_odds=1&max_odds=x", "/?min_odds="} {
+		c := context(httptest.NewRequest(http.MethodGet, u, nil))
+		var actual query
+		assert.Error(t, c.ShouldBindWith(&actual, Query), u)
+	}
+}
+
+func TestForm(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/?sport=football", strings.NewReader("min_odds=2.25&max_odds=3"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	c := context(req)
+
+	var actual query
+	assert.NoError(t, c.ShouldBindWith(&actual, Form))
+	assert.Equal(t, query{
+		MinOdds: decimal.New(225, -2),
+		MaxOdds: decimal.NullNumber{Decimal: decimal.New(3, 0), Valid: true},
+		Sport:   "football",
+	}, actual)
+	assert.Equal(t, "form", Form.Name())
+}
+
+func TestNewQuery(t *testing.T) {
+	b := NewQuery(decimal.Config{Strict: true, Bounds: decimal.Bounds{MinExponent: -2, LimitMinExponent: true}})
+
+	var actual query
+	c := context(httptest.NewRequest(http.MethodGet, "/?min_odds=1.25", nil))
+	assert.NoError(t, c.ShouldBindWith(&actual, b))
+	c = context(httptest.NewRequest(http.MethodGet, "/?min_odds=1e2", nil))
+	assert.Error(t, c.ShouldBindWith(&actual, b))
+	c = context(httptest.NewRequest(http.MethodGet, "/?min_odds=1.255", nil))
+	assert.Error(t, c.ShouldBindWith(&actual, b))
+}
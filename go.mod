@@ -1,8 +1,9 @@
 module github.com/advbet/decimal/v2
 
-go 1.17
+go 1.18
 
 require (
+	github.com/google/go-cmp v0.6.0
 	github.com/shopspring/decimal v1.3.1
 	github.com/stretchr/testify v1.7.0
 )
@@ -0,0 +1,105 @@
+// Package govalues converts decimal numbers to and from
+// github.com/govalues/decimal values without string round-trips.
+package govalues
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/advbet/decimal/v2"
+	gv "github.com/govalues/decimal"
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// maxCoef is the largest coefficient having gv.MaxPrec digits.
+const maxCoef = 9_999_999_999_999_999_999
+
+// FromDecimal converts govalues decimal to a decimal number. The conversion is
+// always exact, scale of the value is preserved.
+func FromDecimal(d gv.Decimal) decimal.Number {
+	coef := new(big.Int).SetUint64(d.Coef())
+	if d.IsNeg() {
+		coef.Neg(coef)
+	}
+	return newDecimal.NewFromBigInt(coef, -int32(d.Scale()))
+}
+
+// ToDecimal converts decimal number to govalues decimal exactly. Trailing
+// zeros are removed if the number has more than gv.MaxScale decimal places.
+// It returns an error matching decimal.ErrPrecisionLoss if the number has
+// more significant decimal places and decimal.ErrOverflow if the coefficient
+// has more than gv.MaxPrec digits.
+func ToDecimal(n decimal.Number) (gv.Decimal, error) {
+	// check significant digits first, so numbers with extreme exponents are
+	// not rescaled
+	digits, exp := decimal.SignificantDigits(n)
+	switch {
+	case digits > 0 && exp < -gv.MaxScale:
+		return gv.Decimal{}, decimal.ErrPrecisionLoss
+	case digits+exp > gv.MaxPrec:
+		return gv.Decimal{}, decimal.ErrOverflow
+	case digits == 0:
+		scale := -int(n.Exponent())
+		if scale < 0 {
+			scale = 0
+		} else if scale > gv.MaxScale {
+			scale = gv.MaxScale
+		}
+		return gv.New(0, scale)
+	}
+
+	if n.Exponent() > 0 {
+		n = decimal.Rescale(n, 0)
+	}
+	if n.Exponent() < -gv.MaxScale {
+		r := decimal.Round(n, -gv.MaxScale, decimal.RoundTruncate)
+		if !r.Equal(n) {
+			return gv.Decimal{}, decimal.ErrPrecisionLoss
+		}
+		n = r
+	}
+
+	coef := n.Coefficient()
+	neg := coef.Sign() < 0
+	coef.Abs(coef)
+	if !coef.IsUint64() || coef.Uint64() > maxCoef {
+		return gv.Decimal{}, decimal.ErrOverflow
+	}
+	d, err := newDecimalUint64(coef.Uint64(), -int(n.Exponent()))
+	if err != nil {
+		return gv.Decimal{}, decimal.ErrOverflow
+	}
+	if neg {
+		d = d.Neg()
+	}
+	return d, nil
+}
+
+// newDecimalUint64 creates govalues decimal coef / 10^scale for coefficients
+// that might not fit into int64.
+func newDecimalUint64(coef uint64, scale int) (gv.Decimal, error) {
+	if coef <= math.MaxInt64 {
+		return gv.New(int64(coef), scale)
+	}
+
+	// coef / 10^scale = (coef/10) / 10^(scale-1) + (coef%10) / 10^scale
+	lo, err := gv.New(int64(coef%10), scale)
+	if err != nil {
+		return gv.Decimal{}, err
+	}
+	if scale == 0 {
+		hi, err := gv.New(int64(coef/10), 0)
+		if err != nil {
+			return gv.Decimal{}, err
+		}
+		if hi, err = hi.Mul(gv.Ten); err != nil {
+			return gv.Decimal{}, err
+		}
+		return hi.Add(lo)
+	}
+	hi, err := gv.New(int64(coef/10), scale-1)
+	if err != nil {
+		return gv.Decimal{}, err
+	}
+	return hi.Add(lo)
+}
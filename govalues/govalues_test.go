@@ -0,0 +1,65 @@
+package govalues
+
+import (
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	gv "github.com/govalues/decimal"
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundTrip(t *testing.T) {
+	inputs := []string{
+		"0", "0.00", "1", "-1.50", "123.456", "-0.0000000000000000001",
+		"9223372036854775807", "9223372036854775808", "-9999999999999999999",
+		"0.9999999999999999999", "999999999.9999999999", "-12345678901234567.89",
+	}
+
+	for _, in := range inputs {
+		d := gv.MustParse(in)
+		n := FromDecimal(d)
+		assert.Equal(t, newDecimal.RequireFromString(in), n, in)
+		assert.Equal(t, d.String(), n.StringFixed(int32(d.Scale())), in)
+
+		back, err := ToDecimal(n)
+		assert.NoError(t, err, in)
+		assert.Equal(t, d, back, in)
+	}
+}
+
+func TestToDecimal(t *testing.T) {
+	tests := []struct {
+		n        decimal.Number
+		expected string
+	}{
+		{decimal.New(15, 2), "1500"},
+		{decimal.New(-15, -19), "-0.0000000000000000015"},
+		{decimal.New(10000000, -25), "0.0000000000000000010"},
+	}
+
+	for _, test := range tests {
+		d, err := ToDecimal(test.n)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, d.String())
+	}
+
+	_, err := ToDecimal(decimal.New(1, -20))
+	assert.ErrorIs(t, err, decimal.ErrPrecisionLoss)
+	_, err = ToDecimal(decimal.New(1, 19))
+	assert.ErrorIs(t, err, decimal.ErrOverflow)
+	_, err = ToDecimal(newDecimal.RequireFromString("1.0000000000000000001"))
+	assert.ErrorIs(t, err, decimal.ErrOverflow)
+
+	// extreme exponents fail without rescaling
+	_, err = ToDecimal(newDecimal.RequireFromString("1e2147483647"))
+	assert.ErrorIs(t, err, decimal.ErrOverflow)
+	_, err = ToDecimal(newDecimal.RequireFromString("-1e-2147483648"))
+	assert.ErrorIs(t, err, decimal.ErrPrecisionLoss)
+	d, err := ToDecimal(newDecimal.RequireFromString("0e-2147483648"))
+	assert.NoError(t, err)
+	assert.Equal(t, "0.0000000000000000000", d.String())
+	d, err = ToDecimal(newDecimal.RequireFromString("0e2147483647"))
+	assert.NoError(t, err)
+	assert.Equal(t, "0", d.String())
+}
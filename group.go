@@ -0,0 +1,62 @@
+package decimal
+
+// SumBy groups items by key and calculates exact sum of amounts in every
+// group.
+func SumBy[T any, K comparable](items []T, key func(T) K, amount func(T) Number) map[K]Number {
+	res := make(map[K]Number)
+	for _, item := range items {
+		k := key(item)
+		if sum, ok := res[k]; ok {
+			res[k] = sum.Add(amount(item))
+		} else {
+			res[k] = amount(item)
+		}
+	}
+	return res
+}
+
+// CountBy groups items by key and counts items in every group.
+func CountBy[T any, K comparable](items []T, key func(T) K) map[K]int {
+	res := make(map[K]int)
+	for _, item := range items {
+		res[key(item)]++
+	}
+	return res
+}
+
+// AvgBy groups items by key and calculates average amount in every group. The
+// sums are exact, every average is rounded once to the given exponent using
+// the given rounding rule.
+func AvgBy[T any, K comparable](items []T, key func(T) K, amount func(T) Number, exp int, rule RoundRule) map[K]Number {
+	sums := SumBy(items, key, amount)
+	counts := CountBy(items, key)
+	for k, sum := range sums {
+		sums[k] = divRound(sum, New(int64(counts[k]), 0), exp, rule)
+	}
+	return sums
+}
+
+// SumMap calculates exact sum of all values of m. The sum has the smallest
+// exponent of the values, so it does not depend on the iteration order. Sum
+// of an empty map is zero.
+func SumMap[K comparable](m map[K]Number) Number {
+	values := make([]Number, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	return sumExact(values)
+}
+
+// MergeAdd adds values of src to values of dst having the same key, keys
+// missing from dst are added with src values. Sums are exact and have the
+// smaller exponent of the two values. dst must not be nil unless src is
+// empty.
+func MergeAdd[K comparable](dst, src map[K]Number) {
+	for k, v := range src {
+		if sum, ok := dst[k]; ok {
+			dst[k] = sum.Add(v)
+		} else {
+			dst[k] = v
+		}
+	}
+}
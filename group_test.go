@@ -0,0 +1,71 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type groupTestBet struct {
+	market string
+	stake  Number
+}
+
+func groupTestBets() []groupTestBet {
+	return []groupTestBet{
+		{"1x2", New(1000, -2)},
+		{"ou", New(250, -2)},
+		{"1x2", New(1, -2)},
+		{"ah", New(-5, 0)},
+		{"1x2", New(2, 0)},
+	}
+}
+
+func betMarket(b groupTestBet) string { return b.market }
+
+func betStake(b groupTestBet) Number { return b.stake }
+
+func TestSumBy(t *testing.T) {
+	sums := SumBy(groupTestBets(), betMarket, betStake)
+
+	assert.Len(t, sums, 3)
+	assert.Equal(t, "12.01", sums["1x2"].String())
+	assert.Equal(t, "2.5", sums["ou"].String())
+	assert.Equal(t, "-5", sums["ah"].String())
+	assert.Empty(t, SumBy(nil, betMarket, betStake))
+}
+
+func TestCountBy(t *testing.T) {
+	assert.Equal(t, map[string]int{"1x2": 3, "ou": 1, "ah": 1}, CountBy(groupTestBets(), betMarket))
+}
+
+func TestAvgBy(t *testing.T) {
+	avgs := AvgBy(groupTestBets(), betMarket, betStake, -2, RoundBankers)
+
+	assert.Equal(t, map[string]Number{
+		"1x2": New(400, -2),
+		"ou":  New(250, -2),
+		"ah":  New(-500, -2),
+	}, avgs)
+}
+
+func TestSumMap(t *testing.T) {
+	m := map[string]Number{"a": New(15, -1), "b": New(250, -2), "c": New(-1, 0), "d": {}}
+	sum := SumMap(m)
+	assert.Equal(t, New(300, -2), sum)
+	assert.Equal(t, Zero(), SumMap(map[int]Number(nil)))
+}
+
+func TestMergeAdd(t *testing.T) {
+	dst := map[string]Number{"a": New(15, -1), "b": New(2, 0)}
+	MergeAdd(dst, map[string]Number{"a": New(250, -2), "c": New(-1, -1)})
+	assert.Equal(t, map[string]Number{
+		"a": New(400, -2),
+		"b": New(2, 0),
+		"c": New(-1, -1),
+	}, dst)
+
+	MergeAdd(dst, nil)
+	assert.Len(t, dst, 3)
+	MergeAdd(map[string]Number(nil), nil)
+}
@@ -0,0 +1,87 @@
+package decimal
+
+import (
+	"sort"
+)
+
+// BucketBound is enum type for specifying which bucket a value equal to a
+// bucket boundary belongs to.
+type BucketBound int
+
+// List of supported bucket boundary modes
+const (
+	LowerInclusive BucketBound = iota // Buckets are [b(i-1), b(i)), boundary starts a bucket
+	UpperInclusive                    // Buckets are (b(i-1), b(i)], boundary ends a bucket
+)
+
+// Bucketize returns bucket index for every value. Boundaries must be sorted in
+// ascending order, n boundaries define n+1 buckets: bucket 0 holds values
+// below boundaries[0], bucket i holds values in [boundaries[i-1],
+// boundaries[i]) and bucket n holds values at or above the last boundary.
+// Comparisons are exact, see Histogram for upper inclusive buckets.
+func Bucketize(values []Number, boundaries []Number) []int {
+	res := make([]int, len(values))
+	for i, v := range values {
+		res[i] = bucket(boundaries, v, LowerInclusive)
+	}
+	return res
+}
+
+// Histogram counts values falling into buckets defined by decimal boundaries.
+type Histogram struct {
+	boundaries []Number
+	bound      BucketBound
+	counts     []int
+}
+
+// NewHistogram creates an empty histogram. Boundaries must be sorted in
+// ascending order, n boundaries define n+1 buckets, see Bucketize. Bound
+// selects the bucket of values equal to a boundary. Boundaries are copied.
+func NewHistogram(boundaries []Number, bound BucketBound) *Histogram {
+	b := make([]Number, len(boundaries))
+	copy(b, boundaries)
+	return &Histogram{
+		boundaries: b,
+		bound:      bound,
+		counts:     make([]int, len(boundaries)+1),
+	}
+}
+
+// Bucket returns index of the bucket n belongs to.
+func (h *Histogram) Bucket(n Number) int {
+	return bucket(h.boundaries, n, h.bound)
+}
+
+// Add counts n in its bucket and returns the bucket index.
+func (h *Histogram) Add(n Number) int {
+	i := h.Bucket(n)
+	h.counts[i]++
+	return i
+}
+
+// Counts returns number of values counted in each bucket. Returned slice must
+// not be modified.
+func (h *Histogram) Counts() []int {
+	return h.counts
+}
+
+// Total returns number of values counted in all buckets.
+func (h *Histogram) Total() int {
+	total := 0
+	for _, c := range h.counts {
+		total += c
+	}
+	return total
+}
+
+// bucket finds bucket index of n using binary search.
+func bucket(boundaries []Number, n Number, bound BucketBound) int {
+	if bound == UpperInclusive {
+		return sort.Search(len(boundaries), func(i int) bool {
+			return Cmp(boundaries[i], n) >= 0
+		})
+	}
+	return sort.Search(len(boundaries), func(i int) bool {
+		return Cmp(boundaries[i], n) > 0
+	})
+}
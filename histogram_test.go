@@ -0,0 +1,40 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketize(t *testing.T) {
+	boundaries := []Number{New(10, -2), New(50, -2), New(100, -2)}
+	values := []Number{
+		New(0, 0), New(99, -3), New(1, -1), New(1000, -4), New(49999, -5),
+		New(5, -1), New(1, 0), New(100, 0), New(-1, 0),
+	}
+
+	assert.Equal(t, []int{0, 0, 1, 1, 1, 2, 3, 3, 0}, Bucketize(values, boundaries))
+	assert.Equal(t, []int{0, 0}, Bucketize([]Number{One, Zero()}, nil))
+	assert.Empty(t, Bucketize(nil, boundaries))
+}
+
+func TestHistogram(t *testing.T) {
+	boundaries := []Number{New(10, -2), New(50, -2), New(100, -2)}
+	values := []Number{New(1, -1), New(5, -1), New(100, -2), New(101, -2), New(5, -2)}
+
+	lower := NewHistogram(boundaries, LowerInclusive)
+	upper := NewHistogram(boundaries, UpperInclusive)
+	for _, v := range values {
+		lower.Add(v)
+		upper.Add(v)
+	}
+
+	assert.Equal(t, []int{1, 1, 1, 2}, lower.Counts())
+	assert.Equal(t, []int{2, 1, 1, 1}, upper.Counts())
+	assert.Equal(t, 5, lower.Total())
+	assert.Equal(t, 2, upper.Bucket(New(1, 0)))
+	assert.Equal(t, 3, lower.Bucket(New(1, 0)))
+
+	boundaries[0] = New(1000, 0)
+	assert.Equal(t, 0, lower.Bucket(New(5, -2)))
+}
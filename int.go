@@ -0,0 +1,106 @@
+package decimal
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// maxIntExp is the largest exponent of a non-zero integer that might fit into
+// 64 bits, 10^20 > math.MaxUint64.
+const maxIntExp = 19
+
+// ToInt64Exact converts n to int64. It fails with ErrPrecisionLoss if n has a
+// fractional part and with ErrOverflow if n does not fit into int64, unlike
+// ScaledVal that silently truncates. Uninitialized Number{} is treated as
+// zero.
+func ToInt64Exact(n Number) (int64, error) {
+	coef, err := integerCoefficient(n)
+	if err != nil {
+		return 0, err
+	}
+	if !coef.IsInt64() {
+		return 0, fmt.Errorf("%w: %s does not fit int64", ErrOverflow, DebugString(n))
+	}
+	return coef.Int64(), nil
+}
+
+// ToUint64Exact converts n to uint64. It fails with ErrPrecisionLoss if n has
+// a fractional part and with ErrOverflow if n is negative or does not fit
+// into uint64. Uninitialized Number{} is treated as zero.
+func ToUint64Exact(n Number) (uint64, error) {
+	coef, err := integerCoefficient(n)
+	if err != nil {
+		return 0, err
+	}
+	if !coef.IsUint64() {
+		return 0, fmt.Errorf("%w: %s does not fit uint64", ErrOverflow, DebugString(n))
+	}
+	return coef.Uint64(), nil
+}
+
+// integerCoefficient returns value of n as an integer. It fails if n has a
+// fractional part or is too large to fit into 64 bits.
+func integerCoefficient(n Number) (*big.Int, error) {
+	if n.Sign() == 0 {
+		return new(big.Int), nil
+	}
+	if HasFraction(n) {
+		return nil, fmt.Errorf("%w: %s has a fractional part", ErrPrecisionLoss, DebugString(n))
+	}
+	if n.Exponent() > maxIntExp {
+		return nil, fmt.Errorf("%w: %s does not fit 64 bits", ErrOverflow, DebugString(n))
+	}
+	return Rescale(n, 0).Coefficient(), nil
+}
+
+// FromInt64 creates a new decimal number with an integer value and zero
+// exponent.
+func FromInt64(v int64) Number {
+	return newDecimal.New(v, 0)
+}
+
+// FromUint64 creates a new decimal number with an integer value and zero
+// exponent. Values above math.MaxInt64 are converted exactly as well.
+func FromUint64(v uint64) Number {
+	if v <= math.MaxInt64 {
+		return newDecimal.New(int64(v), 0)
+	}
+	return newDecimal.NewFromBigInt(new(big.Int).SetUint64(v), 0)
+}
+
+// Numeric is a constraint permitting all integer and floating point types.
+type Numeric interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// FromNumeric creates a new decimal number from a value of any integer or
+// floating point type. Integers are converted exactly, floats are converted
+// to the shortest decimal representation that rounds back to the same float
+// value, as FromFloat64 does. It fails with ErrNotFinite for NaN and
+// infinities.
+func FromNumeric[T Numeric](v T) (Number, error) {
+	switch reflect.TypeOf(v).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return FromInt64(int64(v)), nil
+	case reflect.Float32:
+		f := float32(v)
+		if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+			return Number{}, ErrNotFinite
+		}
+		return newDecimal.NewFromFloat32(f), nil
+	case reflect.Float64:
+		f := float64(v)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return Number{}, ErrNotFinite
+		}
+		return newDecimal.NewFromFloat(f), nil
+	default:
+		return FromUint64(uint64(v)), nil
+	}
+}
@@ -0,0 +1,131 @@
+package decimal
+
+import (
+	"math"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToInt64Exact(t *testing.T) {
+	tests := []struct {
+		n        string
+		expected int64
+		err      error
+	}{
+		{"0", 0, nil},
+		{"0.000", 0, nil},
+		{"0e2147483647", 0, nil},
+		{"12", 12, nil},
+		{"12.00", 12, nil},
+		{"-12", -12, nil},
+		{"1e3", 1000, nil},
+		{"9223372036854775807", math.MaxInt64, nil},
+		{"-9223372036854775808", math.MinInt64, nil},
+		{"9223372036854775808", 0, ErrOverflow},
+		{"-9223372036854775809", 0, ErrOverflow},
+		{"1e19", 0, ErrOverflow},
+		{"1e2147483647", 0, ErrOverflow},
+		{"12.5", 0, ErrPrecisionLoss},
+		{"-0.001", 0, ErrPrecisionLoss},
+		{"1e-2147483648", 0, ErrPrecisionLoss},
+	}
+
+	for _, test := range tests {
+		v, err := ToInt64Exact(newDecimal.RequireFromString(test.n))
+		if test.err != nil {
+			assert.ErrorIs(t, err, test.err, test.n)
+			continue
+		}
+		assert.NoError(t, err, test.n)
+		assert.Equal(t, test.expected, v, test.n)
+	}
+
+	v, err := ToInt64Exact(Number{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), v)
+
+	_, err = ToInt64Exact(New(1, 19))
+	assert.EqualError(t, err, `decimal: overflow: dec(coef=1, exp=19, "10000000000000000000") does not fit int64`)
+}
+
+func TestToUint64Exact(t *testing.T) {
+	tests := []struct {
+		n        string
+		expected uint64
+		err      error
+	}{
+		{"0", 0, nil},
+		{"-0.00", 0, nil},
+		{"12.00", 12, nil},
+		{"1e19", 10000000000000000000, nil},
+		{"18446744073709551615", math.MaxUint64, nil},
+		{"18446744073709551616", 0, ErrOverflow},
+		{"1e20", 0, ErrOverflow},
+		{"-1", 0, ErrOverflow},
+		{"0.5", 0, ErrPrecisionLoss},
+	}
+
+	for _, test := range tests {
+		v, err := ToUint64Exact(newDecimal.RequireFromString(test.n))
+		if test.err != nil {
+			assert.ErrorIs(t, err, test.err, test.n)
+			continue
+		}
+		assert.NoError(t, err, test.n)
+		assert.Equal(t, test.expected, v, test.n)
+	}
+}
+
+func TestFromInt64(t *testing.T) {
+	assert.Equal(t, newDecimal.New(0, 0), FromInt64(0))
+	assert.Equal(t, newDecimal.New(math.MinInt64, 0), FromInt64(math.MinInt64))
+	assert.Equal(t, newDecimal.New(math.MaxInt64, 0), FromInt64(math.MaxInt64))
+}
+
+func TestFromUint64(t *testing.T) {
+	assert.Equal(t, newDecimal.New(0, 0), FromUint64(0))
+	assert.Equal(t, newDecimal.New(math.MaxInt64, 0), FromUint64(math.MaxInt64))
+	assert.Equal(t, "9223372036854775808", FromUint64(math.MaxInt64+1).String())
+	assert.Equal(t, "18446744073709551615", FromUint64(math.MaxUint64).String())
+
+	v, err := ToUint64Exact(FromUint64(math.MaxUint64))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), v)
+}
+
+type stake int32
+
+func TestFromNumeric(t *testing.T) {
+	tests := []struct {
+		convert  func() (Number, error)
+		expected string
+	}{
+		{func() (Number, error) { return FromNumeric(-5) }, "-5"},
+		{func() (Number, error) { return FromNumeric(int8(math.MinInt8)) }, "-128"},
+		{func() (Number, error) { return FromNumeric(uint16(math.MaxUint16)) }, "65535"},
+		{func() (Number, error) { return FromNumeric(int32(math.MinInt32)) }, "-2147483648"},
+		{func() (Number, error) { return FromNumeric(int64(math.MinInt64)) }, "-9223372036854775808"},
+		{func() (Number, error) { return FromNumeric(uint64(math.MaxUint64)) }, "18446744073709551615"},
+		{func() (Number, error) { return FromNumeric(uint(math.MaxUint32)) }, "4294967295"},
+		{func() (Number, error) { return FromNumeric(uintptr(42)) }, "42"},
+		{func() (Number, error) { return FromNumeric(stake(100)) }, "100"},
+		{func() (Number, error) { return FromNumeric(0.1) }, "0.1"},
+		{func() (Number, error) { return FromNumeric(float32(0.1)) }, "0.1"},
+		{func() (Number, error) { return FromNumeric(-123.456) }, "-123.456"},
+	}
+
+	for _, test := range tests {
+		n, err := test.convert()
+		assert.NoError(t, err, test.expected)
+		assert.Equal(t, test.expected, n.String())
+	}
+
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		_, err := FromNumeric(f)
+		assert.ErrorIs(t, err, ErrNotFinite)
+		_, err = FromNumeric(float32(f))
+		assert.ErrorIs(t, err, ErrNotFinite)
+	}
+}
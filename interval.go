@@ -0,0 +1,100 @@
+package decimal
+
+// Interval is a range of decimal numbers between Lower and Upper bounds. Each
+// bound is closed (included in the interval) unless the corresponding Open
+// flag is set.
+type Interval struct {
+	Lower     Number
+	Upper     Number
+	LowerOpen bool
+	UpperOpen bool
+}
+
+// ClosedInterval creates interval [lower, upper].
+func ClosedInterval(lower, upper Number) Interval {
+	return Interval{Lower: lower, Upper: upper}
+}
+
+// OpenInterval creates interval (lower, upper).
+func OpenInterval(lower, upper Number) Interval {
+	return Interval{Lower: lower, Upper: upper, LowerOpen: true, UpperOpen: true}
+}
+
+// IsEmpty returns true if interval contains no numbers.
+func (i Interval) IsEmpty() bool {
+	c := i.Lower.Cmp(i.Upper)
+	return c > 0 || (c == 0 && (i.LowerOpen || i.UpperOpen))
+}
+
+// Contains checks if n is within the interval bounds.
+func (i Interval) Contains(n Number) bool {
+	lower := n.Cmp(i.Lower)
+	if lower < 0 || (lower == 0 && i.LowerOpen) {
+		return false
+	}
+	upper := n.Cmp(i.Upper)
+	return upper < 0 || (upper == 0 && !i.UpperOpen)
+}
+
+// Between checks if n is between low and high. Both bounds are included if
+// inclusive is true and excluded otherwise, so Between(n, low, high, false)
+// is low < n < high. It returns false if low is above high. Uninitialized
+// Number{} is treated as zero.
+func Between(n, low, high Number, inclusive bool) bool {
+	lower, upper := Cmp(n, low), Cmp(n, high)
+	if inclusive {
+		return lower >= 0 && upper <= 0
+	}
+	return lower > 0 && upper < 0
+}
+
+// InRange checks if n is within the interval r honouring its open and closed
+// bounds. It is equivalent to r.Contains(n).
+func InRange(n Number, r Interval) bool {
+	return r.Contains(n)
+}
+
+// Overlaps checks if intervals have at least one common number.
+func (i Interval) Overlaps(other Interval) bool {
+	_, ok := i.Intersect(other)
+	return ok
+}
+
+// Intersect returns intersection of both intervals. The second return value
+// is false if intervals do not overlap.
+func (i Interval) Intersect(other Interval) (Interval, bool) {
+	res := i
+
+	switch c := other.Lower.Cmp(i.Lower); {
+	case c > 0:
+		res.Lower, res.LowerOpen = other.Lower, other.LowerOpen
+	case c == 0:
+		res.LowerOpen = i.LowerOpen || other.LowerOpen
+	}
+
+	switch c := other.Upper.Cmp(i.Upper); {
+	case c < 0:
+		res.Upper, res.UpperOpen = other.Upper, other.UpperOpen
+	case c == 0:
+		res.UpperOpen = i.UpperOpen || other.UpperOpen
+	}
+
+	if res.IsEmpty() {
+		return Interval{}, false
+	}
+	return res, true
+}
+
+// Clamp returns n limited to the interval bounds: Lower if n is below the
+// interval, Upper if n is above the interval and n itself otherwise. Bounds
+// are returned even if they are open, because open interval has no closest
+// number to return instead.
+func (i Interval) Clamp(n Number) Number {
+	if n.Cmp(i.Lower) < 0 {
+		return i.Lower
+	}
+	if n.Cmp(i.Upper) > 0 {
+		return i.Upper
+	}
+	return n
+}
@@ -0,0 +1,139 @@
+package decimal
+
+import (
+	"fmt"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntervalContains(t *testing.T) {
+	tests := []struct {
+		i        Interval
+		n        Number
+		expected bool
+	}{
+		{ClosedInterval(New(1, 0), New(2, 0)), New(1, 0), true},
+		{ClosedInterval(New(1, 0), New(2, 0)), New(200, -2), true},
+		{ClosedInterval(New(1, 0), New(2, 0)), New(15, -1), true},
+		{ClosedInterval(New(1, 0), New(2, 0)), New(99, -2), false},
+		{ClosedInterval(New(1, 0), New(2, 0)), New(201, -2), false},
+		{OpenInterval(New(1, 0), New(2, 0)), New(100, -2), false},
+		{OpenInterval(New(1, 0), New(2, 0)), New(2, 0), false},
+		{OpenInterval(New(1, 0), New(2, 0)), New(101, -2), true},
+		{Interval{Lower: New(1, 0), Upper: New(2, 0), UpperOpen: true}, New(1, 0), true},
+		{Interval{Lower: New(1, 0), Upper: New(2, 0), UpperOpen: true}, New(2, 0), false},
+		{Interval{Lower: New(1, 0), Upper: New(2, 0), LowerOpen: true}, New(1, 0), false},
+		{Interval{Lower: New(1, 0), Upper: New(2, 0), LowerOpen: true}, New(2, 0), true},
+		{Interval{}, Number{}, true},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.i.Contains(test.n), fmt.Sprintf("%+v contains %s", test.i, test.n))
+	}
+}
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		n, low, high Number
+		inclusive    bool
+		expected     bool
+	}{
+		{New(15, -1), New(1, 0), New(2, 0), true, true},
+		{New(15, -1), New(1, 0), New(2, 0), false, true},
+		{New(100, -2), New(1, 0), New(2, 0), true, true},
+		{New(100, -2), New(1, 0), New(2, 0), false, false},
+		{New(2, 0), New(1, 0), New(200, -2), true, true},
+		{New(2, 0), New(1, 0), New(200, -2), false, false},
+		{New(99, -2), New(1, 0), New(2, 0), true, false},
+		{New(201, -2), New(1, 0), New(2, 0), true, false},
+		{New(1, 0), New(1, 0), New(1, 0), true, true},
+		{New(1, 0), New(1, 0), New(1, 0), false, false},
+		{New(15, -1), New(2, 0), New(1, 0), true, false},
+		{Number{}, Number{}, New(1, 0), true, true},
+		{Number{}, New(-1, 0), New(1, 0), false, true},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, Between(test.n, test.low, test.high, test.inclusive),
+			fmt.Sprintf("%s between %s and %s (inclusive %t)", test.n, test.low, test.high, test.inclusive))
+	}
+}
+
+func TestInRange(t *testing.T) {
+	r := Interval{Lower: New(101, -2), Upper: New(1000, 0), UpperOpen: true}
+	assert.True(t, InRange(New(101, -2), r))
+	assert.True(t, InRange(New(9999, -1), r))
+	assert.False(t, InRange(New(1, 0), r))
+	assert.False(t, InRange(New(1000, 0), r))
+	assert.False(t, InRange(New(15, -1), OpenInterval(New(2, 0), New(1, 0))))
+}
+
+func TestIntervalIsEmpty(t *testing.T) {
+	assert.False(t, ClosedInterval(New(1, 0), New(1, 0)).IsEmpty())
+	assert.True(t, OpenInterval(New(1, 0), New(1, 0)).IsEmpty())
+	assert.True(t, Interval{Lower: New(1, 0), Upper: New(1, 0), LowerOpen: true}.IsEmpty())
+	assert.True(t, ClosedInterval(New(2, 0), New(1, 0)).IsEmpty())
+	assert.False(t, OpenInterval(New(1, 0), New(2, 0)).IsEmpty())
+}
+
+func TestIntervalIntersect(t *testing.T) {
+	tests := []struct {
+		a        Interval
+		b        Interval
+		expected Interval
+		ok       bool
+	}{
+		{
+			a:        ClosedInterval(New(1, 0), New(3, 0)),
+			b:        ClosedInterval(New(2, 0), New(4, 0)),
+			expected: ClosedInterval(New(2, 0), New(3, 0)),
+			ok:       true,
+		},
+		{
+			a:        ClosedInterval(New(1, 0), New(2, 0)),
+			b:        ClosedInterval(New(2, 0), New(3, 0)),
+			expected: ClosedInterval(New(2, 0), New(2, 0)),
+			ok:       true,
+		},
+		{
+			a:  Interval{Lower: New(1, 0), Upper: New(2, 0), UpperOpen: true},
+			b:  ClosedInterval(New(2, 0), New(3, 0)),
+			ok: false,
+		},
+		{
+			a:        ClosedInterval(New(1, 0), New(3, 0)),
+			b:        OpenInterval(New(1, 0), New(3, 0)),
+			expected: OpenInterval(New(1, 0), New(3, 0)),
+			ok:       true,
+		},
+		{
+			a:        ClosedInterval(New(1, 0), New(5, 0)),
+			b:        Interval{Lower: New(2, 0), Upper: New(3, 0), LowerOpen: true},
+			expected: Interval{Lower: New(2, 0), Upper: New(3, 0), LowerOpen: true},
+			ok:       true,
+		},
+		{
+			a:  ClosedInterval(New(1, 0), New(2, 0)),
+			b:  ClosedInterval(New(3, 0), New(4, 0)),
+			ok: false,
+		},
+	}
+
+	for _, test := range tests {
+		actual, ok := test.a.Intersect(test.b)
+		assert.Equal(t, test.ok, ok, fmt.Sprintf("%+v intersect %+v", test.a, test.b))
+		assert.Equal(t, test.expected, actual, fmt.Sprintf("%+v intersect %+v", test.a, test.b))
+		assert.Equal(t, test.ok, test.b.Overlaps(test.a))
+	}
+}
+
+func TestIntervalClamp(t *testing.T) {
+	i := ClosedInterval(newDecimal.New(101, -2), newDecimal.New(1000, 0))
+
+	assert.Equal(t, newDecimal.New(101, -2), i.Clamp(New(1, 0)))
+	assert.Equal(t, newDecimal.New(1000, 0), i.Clamp(New(1001, 0)))
+	assert.Equal(t, newDecimal.New(5, 0), i.Clamp(New(5, 0)))
+	assert.Equal(t, newDecimal.New(101, -2), OpenInterval(New(101, -2), New(2, 0)).Clamp(New(-1, 0)))
+}
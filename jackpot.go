@@ -0,0 +1,73 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// JackpotContribution calculates jackpot contribution of a stake and splits
+// it between pots, e.g. splits 0.1, 0.7 and 0.2 for seed, main and reserve
+// pots. The contribution stake * contributionRate is truncated to the given
+// exponent, so pots never receive more than the exact contribution. Every pot
+// receives its share truncated to whole units of 10^exp and the remaining
+// units go one each to the pots having the largest truncated remainders, ties
+// are given to the lower index. Returned parts always sum exactly to the
+// contribution.
+//
+// ErrInvalidSplits is returned if there are no splits, any split is negative
+// or splits do not sum to 1.
+func JackpotContribution(stake Number, contributionRate Number, splits []Number, exp int) ([]Number, error) {
+	if len(splits) == 0 {
+		return nil, fmt.Errorf("%w: no splits", ErrInvalidSplits)
+	}
+	for _, s := range splits {
+		if s.Sign() < 0 {
+			return nil, fmt.Errorf("%w: negative split %s", ErrInvalidSplits, DebugString(s))
+		}
+	}
+	if total := sumExact(splits); total.Cmp(One) != 0 {
+		return nil, fmt.Errorf("%w: splits sum to %s", ErrInvalidSplits, DebugString(total))
+	}
+
+	contribution := Round(stake.Mul(contributionRate), exp, RoundTruncate)
+	units := Rescale(contribution, int32(exp)).Coefficient()
+	neg := units.Sign() < 0
+	units.Abs(units)
+	unitsNum := newDecimal.NewFromBigInt(units, 0)
+
+	// truncated shares and their remainders
+	shares := make([]*big.Int, len(splits))
+	remainders := make([]Number, len(splits))
+	left := new(big.Int).Set(units)
+	for i, s := range splits {
+		share := unitsNum.Mul(s)
+		whole := Round(share, 0, RoundTruncate)
+		shares[i] = Rescale(whole, 0).Coefficient()
+		remainders[i] = share.Sub(whole)
+		left.Sub(left, shares[i])
+	}
+
+	order := make([]int, len(splits))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return remainders[order[i]].Cmp(remainders[order[j]]) > 0
+	})
+	// less units are left than there are splits
+	for _, i := range order[:left.Int64()] {
+		shares[i].Add(shares[i], big.NewInt(1))
+	}
+
+	parts := make([]Number, len(splits))
+	for i, share := range shares {
+		if neg {
+			share.Neg(share)
+		}
+		parts[i] = newDecimal.NewFromBigInt(share, int32(exp))
+	}
+	return parts, nil
+}
@@ -0,0 +1,46 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJackpotContribution(t *testing.T) {
+	splits := numbers("0.1", "0.7", "0.2")
+	tests := []struct {
+		stake    string
+		rate     string
+		splits   []Number
+		expected []string
+	}{
+		{"1", "0.015", splits, []string{"0", "0.01", "0"}},
+		// tie of remainders goes to the lower index
+		{"10", "0.015", splits, []string{"0.02", "0.1", "0.03"}},
+		{"-10", "0.015", splits, []string{"-0.02", "-0.1", "-0.03"}},
+		{"0.5", "0.015", splits, []string{"0", "0", "0"}},
+		{"123.45", "0.02", numbers("0.3333", "0.3333", "0.3334"), []string{"0.82", "0.82", "0.82"}},
+		{"100", "0.05", numbers("1"), []string{"5"}},
+		{"100", "0.05", numbers("0", "1"), []string{"0", "5"}},
+	}
+
+	for _, test := range tests {
+		n := numbers(test.stake, test.rate)
+		parts, err := JackpotContribution(n[0], n[1], test.splits, -2)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, numberStrings(parts), "%s * %s", test.stake, test.rate)
+
+		contribution := Round(n[0].Mul(n[1]), -2, RoundTruncate)
+		assert.True(t, sumExact(parts).Equal(contribution), "%s * %s", test.stake, test.rate)
+	}
+}
+
+func TestJackpotContributionErrors(t *testing.T) {
+	for _, splits := range [][]Number{nil, numbers("0.5", "0.4"), numbers("1.2", "-0.2")} {
+		_, err := JackpotContribution(Ten, New(1, -2), splits, -2)
+		assert.ErrorIs(t, err, ErrInvalidSplits, "%v", numberStrings(splits))
+	}
+
+	_, err := JackpotContribution(Ten, New(1, -2), numbers("0.5", "0.4"), -2)
+	assert.EqualError(t, err, `decimal: invalid splits: splits sum to dec(coef=9, exp=-1, "0.9")`)
+}
@@ -0,0 +1,41 @@
+package decimal
+
+// StringNumber is a decimal number that is always marshaled to JSON as a
+// quoted string, e.g. "12.34", regardless of the global
+// MarshalJSONWithoutQuotes setting. Both JSON strings and JSON numbers are
+// accepted when unmarshaling. All other methods are those of the embedded
+// Number.
+type StringNumber struct {
+	Number
+}
+
+// PlainNumber is a decimal number that is always marshaled to JSON as a bare
+// JSON number, e.g. 12.34, regardless of the global MarshalJSONWithoutQuotes
+// setting. Both JSON strings and JSON numbers are accepted when
+// unmarshaling. All other methods are those of the embedded Number.
+type PlainNumber struct {
+	Number
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n StringNumber) MarshalJSON() ([]byte, error) {
+	dst := make([]byte, 0, appendBufSize)
+	dst = append(dst, '"')
+	dst = AppendText(dst, n.Number)
+	return append(dst, '"'), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *StringNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(&n.Number, data)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n PlainNumber) MarshalJSON() ([]byte, error) {
+	return AppendText(make([]byte, 0, appendBufSize), n.Number), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *PlainNumber) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(&n.Number, data)
+}
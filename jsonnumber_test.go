@@ -0,0 +1,76 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONRepresentation(t *testing.T) {
+	type quote struct {
+		Odds  StringNumber `json:"odds"`
+		Stake PlainNumber  `json:"stake"`
+		Plain Number       `json:"plain"`
+	}
+	q := quote{
+		Odds:  StringNumber{New(150, -2)},
+		Stake: PlainNumber{New(-1005, -1)},
+		Plain: New(2, 0),
+	}
+
+	data, err := json.Marshal(q)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"odds":"1.5","stake":-100.5,"plain":2}`, string(data))
+
+	newDecimal.MarshalJSONWithoutQuotes = false
+	defer func() { newDecimal.MarshalJSONWithoutQuotes = true }()
+	data, err = json.Marshal(q)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"odds":"1.5","stake":-100.5,"plain":"2"}`, string(data))
+
+	for _, input := range []string{
+		`{"odds":"1.50","stake":"-100.5","plain":"2"}`,
+		`{"odds":1.50,"stake":-100.5,"plain":2}`,
+	} {
+		var actual quote
+		assert.NoError(t, json.Unmarshal([]byte(input), &actual), input)
+		assert.Equal(t, New(150, -2), actual.Odds.Number, input)
+		assert.Equal(t, New(-1005, -1), actual.Stake.Number, input)
+	}
+
+	var actual quote
+	assert.Error(t, json.Unmarshal([]byte(`{"odds":"x"}`), &actual))
+	assert.Error(t, json.Unmarshal([]byte(`{"stake":true}`), &actual))
+}
+
+func TestJSONRepresentationMethods(t *testing.T) {
+	s := StringNumber{New(1234, -2)}
+	p := PlainNumber{New(1234, -2)}
+	assert.Equal(t, "12.34", s.String())
+	assert.True(t, p.Add(s.Number).Equal(New(2468, -2)))
+
+	// embedded Number provides text and database representations
+	text, err := s.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "12.34", string(text))
+	assert.NoError(t, p.Scan("1.5"))
+	assert.Equal(t, New(15, -1), p.Number)
+
+	var zero StringNumber
+	data, err := json.Marshal(zero)
+	assert.NoError(t, err)
+	assert.Equal(t, `"0"`, string(data))
+}
+
+func TestJSONRepresentationBounds(t *testing.T) {
+	defer SetDecodeOptions(CurrentDecodeOptions())
+	SetDecodeOptions(DecodeOptions{Bounds: Bounds{MinExponent: -2, LimitMinExponent: true}})
+
+	var s StringNumber
+	assert.ErrorIs(t, json.Unmarshal([]byte(`"1.234"`), &s), ErrOutOfBounds)
+	var p PlainNumber
+	assert.ErrorIs(t, json.Unmarshal([]byte(`1.234`), &p), ErrOutOfBounds)
+	assert.NoError(t, json.Unmarshal([]byte(`1.23`), &p))
+}
@@ -0,0 +1,164 @@
+package decimal
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+	"math/big"
+	"strconv"
+	"strings"
+
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// Key returns canonical string encoding of a decimal number that can be used
+// as a map key. Numerically equal numbers have equal keys regardless of their
+// exponents, e.g. both 1.5 and 1.50 are encoded as "15e-1".
+func Key(n Number) string {
+	n = normalize(n)
+	return n.Coefficient().String() + "e" + strconv.Itoa(int(n.Exponent()))
+}
+
+// CanonicalString returns deterministic decimal text of a number, suitable
+// for signed payloads and idempotency keys. Numerically equal numbers have
+// equal canonical strings. The grammar is
+//
+//	canonical = "0" | ["-"] integer ["." fraction]
+//	integer   = "0" | nonzero {digit}
+//	fraction  = {digit} nonzero
+//
+// i.e. there is no exponent, no plus sign, no negative zero, no leading zeros
+// in the integer part and no trailing zeros in the fraction, e.g. -1.50 is
+// encoded as "-1.5" and 1e3 as "1000". Length of the result grows with the
+// magnitude of the exponent.
+func CanonicalString(n Number) string {
+	n = normalize(n)
+	coef := n.Coefficient()
+	if coef.Sign() == 0 {
+		return "0"
+	}
+
+	var sb strings.Builder
+	if coef.Sign() < 0 {
+		sb.WriteByte('-')
+		coef.Neg(coef)
+	}
+	digits := coef.String()
+	exp := int(n.Exponent())
+	switch point := len(digits) + exp; {
+	case exp >= 0:
+		sb.WriteString(digits)
+		sb.WriteString(strings.Repeat("0", exp))
+	case point > 0:
+		sb.WriteString(digits[:point])
+		sb.WriteByte('.')
+		sb.WriteString(digits[point:])
+	default:
+		sb.WriteString("0.")
+		sb.WriteString(strings.Repeat("0", -point))
+		sb.WriteString(digits)
+	}
+	return sb.String()
+}
+
+// Key128 is a comparable representation of a decimal number having up to 128
+// bit coefficient. Numerically equal numbers produce equal Key128 values, so it
+// can be used as a map key or compared with ==.
+type Key128 struct {
+	hi  uint64
+	lo  uint64
+	exp int32
+	neg bool
+}
+
+// NewKey128 creates Key128 for a given number. The second return value is
+// false if the normalized coefficient does not fit into 128 bits.
+func NewKey128(n Number) (Key128, bool) {
+	n = normalize(n)
+	coef := n.Coefficient()
+	neg := coef.Sign() < 0
+	coef.Abs(coef)
+	if coef.BitLen() > 128 {
+		return Key128{}, false
+	}
+
+	lo := new(big.Int).And(coef, new(big.Int).SetUint64(^uint64(0))).Uint64()
+	hi := coef.Rsh(coef, 64).Uint64()
+	return Key128{hi: hi, lo: lo, exp: n.Exponent(), neg: neg}, true
+}
+
+// Number converts the key back to a decimal number. Returned number is
+// normalized, it has no trailing zeros in its coefficient.
+func (k Key128) Number() Number {
+	coef := new(big.Int).SetUint64(k.hi)
+	coef.Lsh(coef, 64)
+	coef.Or(coef, new(big.Int).SetUint64(k.lo))
+	if k.neg {
+		coef.Neg(coef)
+	}
+	return newDecimal.NewFromBigInt(coef, k.exp)
+}
+
+// Hash writes canonical binary encoding of a decimal number to h.
+// Numerically equal numbers write equal bytes regardless of their exponents.
+// The encoding is length-prefixed, so hashing several numbers in sequence is
+// unambiguous. It holds the sign, the normalized exponent as a big-endian
+// int32 and the normalized coefficient magnitude as a big-endian uint32
+// length followed by big-endian bytes.
+func Hash(n Number, h hash.Hash) {
+	n = normalize(n)
+	coef := n.Coefficient()
+
+	var buf [9]byte
+	buf[0] = byte(coef.Sign() + 1)
+	binary.BigEndian.PutUint32(buf[1:5], uint32(n.Exponent()))
+	mag := coef.Abs(coef).Bytes()
+	binary.BigEndian.PutUint32(buf[5:9], uint32(len(mag)))
+	h.Write(buf[:])
+	h.Write(mag)
+}
+
+// Hash64 returns 64-bit FNV-1a hash of the canonical encoding written by
+// Hash. The value is stable across processes and releases, so it can be used
+// for deduplication and consistent hashing.
+func Hash64(n Number) uint64 {
+	h := fnv.New64a()
+	Hash(n, h)
+	return h.Sum64()
+}
+
+// normalize removes trailing zeros from the coefficient, zero is always
+// normalized to 0 * 10^0.
+func normalize(n Number) Number {
+	coef := n.Coefficient()
+	if coef.Sign() == 0 {
+		return New(0, 0)
+	}
+
+	exp := n.Exponent()
+	ten := big.NewInt(10)
+	q, r := new(big.Int), new(big.Int)
+	for {
+		q.QuoRem(coef, ten, r)
+		if r.Sign() != 0 {
+			break
+		}
+		coef, q = q, coef
+		exp++
+	}
+	return newDecimal.NewFromBigInt(coef, exp)
+}
+
+// SignificantDigits returns number of digits in the normalized coefficient of
+// n and its exponent, e.g. 12.3400 has 4 significant digits and exponent -2.
+// Zero has no significant digits. The number is not rescaled, so magnitude
+// |n| < 10^(digits+exp) can be checked cheaply even for extreme exponents.
+// Uninitialized Number{} is treated as zero.
+func SignificantDigits(n Number) (digits, exp int64) {
+	n = normalize(n)
+	if n.Sign() == 0 {
+		return 0, 0
+	}
+	coef := n.Coefficient()
+	return int64(len(coef.Abs(coef).String())), int64(n.Exponent())
+}
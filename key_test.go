@@ -0,0 +1,170 @@
+package decimal
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		n   Number
+		key string
+	}{
+		{Number{}, "0e0"},
+		{New(0, -5), "0e0"},
+		{New(0, 3), "0e0"},
+		{New(15, -1), "15e-1"},
+		{New(1500, -3), "15e-1"},
+		{New(-1500, -3), "-15e-1"},
+		{New(100, 0), "1e2"},
+		{New(1, 2), "1e2"},
+		{New(123, 0), "123e0"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.key, Key(test.n), test.n.String())
+	}
+
+	m := map[string]int{}
+	m[Key(New(2, 0))]++
+	m[Key(New(200, -2))]++
+	assert.Len(t, m, 1)
+}
+
+func TestCanonicalString(t *testing.T) {
+	tests := []struct {
+		n        Number
+		expected string
+	}{
+		{Number{}, "0"},
+		{New(0, -5), "0"},
+		{New(0, 5), "0"},
+		{New(1, 0), "1"},
+		{New(-150, -2), "-1.5"},
+		{New(1, 3), "1000"},
+		{New(1000, -3), "1"},
+		{New(5, -3), "0.005"},
+		{New(-12345, -2), "-123.45"},
+		{New(12345, -5), "0.12345"},
+		{newDecimal.RequireFromString("1.2e-10"), "0.00000000012"},
+		{newDecimal.RequireFromString("123456789012345678901234567890.1230"), "123456789012345678901234567890.123"},
+	}
+
+	for _, test := range tests {
+		actual := CanonicalString(test.n)
+		assert.Equal(t, test.expected, actual, test.expected)
+
+		// canonical string parses back to an equal number
+		parsed, err := FromString(actual)
+		assert.NoError(t, err, test.expected)
+		assert.True(t, parsed.Equal(test.n), test.expected)
+		assert.Equal(t, actual, CanonicalString(parsed), test.expected)
+	}
+}
+
+func TestKey128(t *testing.T) {
+	a, ok := NewKey128(New(1500, -3))
+	assert.True(t, ok)
+	b, ok := NewKey128(New(15, -1))
+	assert.True(t, ok)
+	assert.True(t, a == b)
+	assert.Equal(t, newDecimal.New(15, -1), a.Number())
+
+	z1, ok := NewKey128(Number{})
+	assert.True(t, ok)
+	z2, ok := NewKey128(New(0, -2))
+	assert.True(t, ok)
+	assert.True(t, z1 == z2)
+	assert.True(t, z1.Number().IsZero())
+
+	neg, ok := NewKey128(New(-15, -1))
+	assert.True(t, ok)
+	assert.False(t, neg == a)
+	assert.Equal(t, newDecimal.New(-15, -1), neg.Number())
+
+	// largest 128 bit coefficient
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+	k, ok := NewKey128(newDecimal.NewFromBigInt(max, -4))
+	assert.True(t, ok)
+	assert.Equal(t, newDecimal.NewFromBigInt(max, -4), k.Number())
+
+	_, ok = NewKey128(newDecimal.NewFromBigInt(new(big.Int).Lsh(big.NewInt(3), 128), 0))
+	assert.False(t, ok)
+}
+
+func TestHash(t *testing.T) {
+	sum := func(ns ...Number) []byte {
+		h := sha256.New()
+		for _, n := range ns {
+			Hash(n, h)
+		}
+		return h.Sum(nil)
+	}
+
+	assert.Equal(t, sum(New(15, -1)), sum(New(1500, -3)))
+	assert.Equal(t, sum(Number{}), sum(New(0, -2)))
+	assert.Equal(t, sum(New(1, 2)), sum(New(100, 0)))
+	assert.NotEqual(t, sum(New(15, -1)), sum(New(-15, -1)))
+	assert.NotEqual(t, sum(New(15, -1)), sum(New(15, -2)))
+	assert.NotEqual(t, sum(New(1, 0), New(2, 0)), sum(New(2, 0), New(1, 0)))
+	assert.NotEqual(t, sum(New(256, 0)), sum(New(1, 0), New(0, 0)))
+
+	// sign, exponent -2, coefficient length 2, coefficient 1234
+	assert.Equal(t, []byte{0, 0xff, 0xff, 0xff, 0xfe, 0, 0, 0, 2, 0x04, 0xd2}, encodeHash(New(-123400, -4)))
+}
+
+func TestHash64(t *testing.T) {
+	assert.Equal(t, Hash64(New(15, -1)), Hash64(New(1500, -3)))
+	assert.Equal(t, Hash64(Number{}), Hash64(Zero()))
+	assert.NotEqual(t, Hash64(New(15, -1)), Hash64(New(-15, -1)))
+	large := newDecimal.NewFromBigInt(new(big.Int).Lsh(big.NewInt(1), 200), -10)
+	assert.Equal(t, Hash64(large), Hash64(Rescale(large, -20)))
+
+	// hash values must be stable across releases
+	assert.Equal(t, uint64(0x867194dcd6ef4a92), Hash64(New(1234, -2)))
+}
+
+func TestSignificantDigits(t *testing.T) {
+	tests := []struct {
+		n      string
+		digits int64
+		exp    int64
+	}{
+		{"0", 0, 0},
+		{"0.000", 0, 0},
+		{"12.3400", 4, -2},
+		{"-1200", 2, 2},
+		{"1e-2147483648", 1, -2147483648},
+		{"1e2147483647", 1, 2147483647},
+	}
+
+	for _, test := range tests {
+		digits, exp := SignificantDigits(newDecimal.RequireFromString(test.n))
+		assert.Equal(t, test.digits, digits, test.n)
+		assert.Equal(t, test.exp, exp, test.n)
+	}
+
+	digits, exp := SignificantDigits(Number{})
+	assert.Equal(t, int64(0), digits)
+	assert.Equal(t, int64(0), exp)
+}
+
+// encodeHash returns bytes written by Hash.
+func encodeHash(n Number) []byte {
+	var w hashRecorder
+	Hash(n, &w)
+	return w.Bytes()
+}
+
+type hashRecorder struct {
+	bytes.Buffer
+}
+
+func (*hashRecorder) Sum(b []byte) []byte { return b }
+func (*hashRecorder) Size() int           { return 0 }
+func (*hashRecorder) BlockSize() int      { return 1 }
@@ -0,0 +1,133 @@
+package decimal
+
+import (
+	"fmt"
+)
+
+// TickRange is a range of prices [From, To] with ticks every Step.
+type TickRange struct {
+	From Number
+	To   Number
+	Step Number
+}
+
+// Ladder is an ordered set of valid prices (ticks) built from contiguous tick
+// ranges, e.g. 1.01-2.00 step 0.01 followed by 2.00-3.00 step 0.02. Ladder is
+// immutable and safe for concurrent use.
+type Ladder struct {
+	ranges []TickRange
+}
+
+// NewLadder creates a ladder from tick ranges sorted in ascending order. Every
+// range must start where the previous one ends, have a positive step and its
+// width must be a multiple of the step. Returned error matches
+// ErrInvalidLadder.
+func NewLadder(ranges ...TickRange) (*Ladder, error) {
+	if len(ranges) == 0 {
+		return nil, fmt.Errorf("%w: no tick ranges", ErrInvalidLadder)
+	}
+
+	for i, r := range ranges {
+		if !r.Step.IsPositive() || r.To.Cmp(r.From) <= 0 {
+			return nil, fmt.Errorf("%w: range %s-%s step %s", ErrInvalidLadder, DebugString(r.From), DebugString(r.To), DebugString(r.Step))
+		}
+		if !r.To.Sub(r.From).Mod(r.Step).IsZero() {
+			return nil, fmt.Errorf("%w: range %s-%s is not a multiple of step %s", ErrInvalidLadder, DebugString(r.From), DebugString(r.To), DebugString(r.Step))
+		}
+		if i > 0 && !ranges[i-1].To.Equal(r.From) {
+			return nil, fmt.Errorf("%w: gap between %s and %s", ErrInvalidLadder, DebugString(ranges[i-1].To), DebugString(r.From))
+		}
+	}
+
+	l := &Ladder{ranges: make([]TickRange, len(ranges))}
+	copy(l.ranges, ranges)
+	return l, nil
+}
+
+// Min returns the lowest tick of the ladder.
+func (l *Ladder) Min() Number {
+	return l.ranges[0].From
+}
+
+// Max returns the highest tick of the ladder.
+func (l *Ladder) Max() Number {
+	return l.ranges[len(l.ranges)-1].To
+}
+
+// Contains checks if n is a valid tick of the ladder.
+func (l *Ladder) Contains(n Number) bool {
+	r, ok := l.rangeOf(n)
+	return ok && n.Sub(r.From).Mod(r.Step).IsZero()
+}
+
+// Snap moves n to a tick of the ladder using the given rounding rule, e.g.
+// RoundFloor selects the tick at or below n and RoundBankers the nearest tick.
+// Numbers outside of the ladder are clamped to Min or Max.
+func (l *Ladder) Snap(n Number, rule RoundRule) Number {
+	if n.Cmp(l.Min()) <= 0 {
+		return l.Min()
+	}
+	if n.Cmp(l.Max()) >= 0 {
+		return l.Max()
+	}
+
+	r, _ := l.rangeOf(n)
+	// count steps from zero if possible, so RoundBankers ties go to even
+	// multiples of the step regardless of where the range starts
+	origin := r.From
+	if r.From.Mod(r.Step).IsZero() {
+		origin = Zero()
+	}
+	k := divRound(n.Sub(origin), r.Step, 0, rule)
+	return origin.Add(k.Mul(r.Step))
+}
+
+// NextTick returns the lowest tick greater than n. It returns false if n is
+// at or above Max.
+func (l *Ladder) NextTick(n Number) (Number, bool) {
+	if n.Cmp(l.Min()) < 0 {
+		return l.Min(), true
+	}
+	if n.Cmp(l.Max()) >= 0 {
+		return Number{}, false
+	}
+
+	r, _ := l.rangeOf(n)
+	k := divRound(n.Sub(r.From), r.Step, 0, RoundFloor).Add(One)
+	return r.From.Add(k.Mul(r.Step)), true
+}
+
+// PrevTick returns the highest tick less than n. It returns false if n is at
+// or below Min.
+func (l *Ladder) PrevTick(n Number) (Number, bool) {
+	if n.Cmp(l.Max()) > 0 {
+		return l.Max(), true
+	}
+	if n.Cmp(l.Min()) <= 0 {
+		return Number{}, false
+	}
+
+	// find range with From < n <= To
+	r := l.ranges[0]
+	for _, r = range l.ranges {
+		if n.Cmp(r.To) <= 0 {
+			break
+		}
+	}
+	k := divRound(n.Sub(r.From), r.Step, 0, RoundCeil).Sub(One)
+	return r.From.Add(k.Mul(r.Step)), true
+}
+
+// rangeOf returns range with From <= n < To, the last range also includes its
+// upper bound.
+func (l *Ladder) rangeOf(n Number) (TickRange, bool) {
+	for i, r := range l.ranges {
+		if n.Cmp(r.From) < 0 {
+			return TickRange{}, false
+		}
+		if c := n.Cmp(r.To); c < 0 || c == 0 && i == len(l.ranges)-1 {
+			return r, true
+		}
+	}
+	return TickRange{}, false
+}
@@ -0,0 +1,128 @@
+package decimal
+
+import (
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func testLadder(t *testing.T) *Ladder {
+	l, err := NewLadder(
+		TickRange{From: New(101, -2), To: New(2, 0), Step: New(1, -2)},
+		TickRange{From: New(2, 0), To: New(3, 0), Step: New(2, -2)},
+		TickRange{From: New(3, 0), To: New(4, 0), Step: New(5, -2)},
+	)
+	assert.NoError(t, err)
+	return l
+}
+
+func TestNewLadderInvalid(t *testing.T) {
+	tests := [][]TickRange{
+		nil,
+		{{From: New(1, 0), To: New(2, 0), Step: Zero()}},
+		{{From: New(2, 0), To: New(1, 0), Step: New(1, -2)}},
+		{{From: New(1, 0), To: New(2, 0), Step: New(3, -1)}},
+		{
+			{From: New(1, 0), To: New(2, 0), Step: New(1, -1)},
+			{From: New(21, -1), To: New(3, 0), Step: New(1, -1)},
+		},
+	}
+
+	for _, ranges := range tests {
+		_, err := NewLadder(ranges...)
+		assert.ErrorIs(t, err, ErrInvalidLadder)
+	}
+}
+
+func TestLadderContains(t *testing.T) {
+	l := testLadder(t)
+	assert.Equal(t, "1.01", l.Min().String())
+	assert.Equal(t, "4", l.Max().String())
+
+	for _, s := range []string{"1.01", "1.5", "1.99", "2", "2.02", "2.98", "3", "3.05", "3.95", "4", "4.00"} {
+		assert.True(t, l.Contains(newDecimal.RequireFromString(s)), s)
+	}
+	for _, s := range []string{"1", "1.005", "2.01", "2.99", "3.01", "4.05", "0"} {
+		assert.False(t, l.Contains(newDecimal.RequireFromString(s)), s)
+	}
+}
+
+func TestLadderSnap(t *testing.T) {
+	l := testLadder(t)
+	tests := []struct {
+		n     string
+		rule  RoundRule
+		ticks string
+	}{
+		{"1.555", RoundFloor, "1.55"},
+		{"1.555", RoundCeil, "1.56"},
+		{"1.555", RoundBankers, "1.56"},
+		{"1.545", RoundBankers, "1.54"},
+		{"1.545", RoundMath, "1.55"},
+		{"2.01", RoundFloor, "2"},
+		{"2.01", RoundCeil, "2.02"},
+		{"2.011", RoundBankers, "2.02"},
+		{"2.999", RoundCeil, "3"},
+		{"3.06", RoundTruncate, "3.05"},
+		{"3.08", RoundBankers, "3.1"},
+		{"1.995", RoundCeil, "2"},
+		{"1", RoundCeil, "1.01"},
+		{"100", RoundFloor, "4"},
+		{"2.02", RoundFloor, "2.02"},
+	}
+
+	for _, test := range tests {
+		actual := l.Snap(newDecimal.RequireFromString(test.n), test.rule)
+		assert.Equal(t, test.ticks, actual.String(), "%s rule %d", test.n, test.rule)
+		assert.True(t, l.Contains(actual))
+	}
+}
+
+func TestLadderNextPrevTick(t *testing.T) {
+	l := testLadder(t)
+	tests := []struct {
+		n      string
+		next   string
+		nextOK bool
+		prev   string
+		prevOK bool
+	}{
+		{"1", "1.01", true, "", false},
+		{"1.01", "1.02", true, "", false},
+		{"1.015", "1.02", true, "1.01", true},
+		{"1.99", "2", true, "1.98", true},
+		{"2", "2.02", true, "1.99", true},
+		{"2.01", "2.02", true, "2", true},
+		{"3", "3.05", true, "2.98", true},
+		{"3.95", "4", true, "3.9", true},
+		{"4", "", false, "3.95", true},
+		{"5", "", false, "4", true},
+	}
+
+	for _, test := range tests {
+		n := newDecimal.RequireFromString(test.n)
+		next, ok := l.NextTick(n)
+		assert.Equal(t, test.nextOK, ok, test.n)
+		if ok {
+			assert.Equal(t, test.next, next.String(), test.n)
+		}
+		prev, ok := l.PrevTick(n)
+		assert.Equal(t, test.prevOK, ok, test.n)
+		if ok {
+			assert.Equal(t, test.prev, prev.String(), test.n)
+		}
+	}
+}
+
+func TestLadderWalk(t *testing.T) {
+	l := testLadder(t)
+	count := 1
+	for n, ok := l.Min(), true; ok; n, ok = l.NextTick(n) {
+		if n.Equal(l.Max()) {
+			break
+		}
+		count++
+	}
+	assert.Equal(t, 100+50+20, count)
+}
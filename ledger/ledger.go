@@ -0,0 +1,111 @@
+// Package ledger implements double-entry bookkeeping checks over decimal
+// amounts. An Entry is balanced when the sum of its debit postings equals the
+// sum of its credit postings exactly, after every posting is rounded to the
+// entry exponent.
+package ledger
+
+import (
+	"fmt"
+
+	"github.com/advbet/decimal/v2"
+)
+
+// Side is enum type for specifying direction of a posting.
+type Side int
+
+// List of posting sides
+const (
+	Debit Side = iota
+	Credit
+)
+
+func (s Side) String() string {
+	switch s {
+	case Debit:
+		return "debit"
+	case Credit:
+		return "credit"
+	default:
+		return fmt.Sprintf("Side(%d)", int(s))
+	}
+}
+
+// Posting is a single movement of an amount to or from an account. Amount
+// must not be negative, direction is defined by Side.
+type Posting struct {
+	Account  string
+	Side     Side
+	Amount   decimal.Number
+	Currency string
+}
+
+// Policy defines how posting amounts are rounded before they are summed.
+type Policy struct {
+	Exp  int
+	Rule decimal.RoundRule
+}
+
+// Entry is a set of postings that must balance.
+type Entry struct {
+	Postings []Posting
+	Policy   Policy
+}
+
+// ImbalanceError is returned when entry debits do not equal credits.
+type ImbalanceError struct {
+	Debit  decimal.Number // Debit is the rounded sum of debit postings
+	Credit decimal.Number // Credit is the rounded sum of credit postings
+}
+
+func (e *ImbalanceError) Error() string {
+	return fmt.Sprintf(
+		"ledger: entry is not balanced: debit %s, credit %s",
+		decimal.DebugString(e.Debit),
+		decimal.DebugString(e.Credit),
+	)
+}
+
+// Difference returns debit minus credit.
+func (e *ImbalanceError) Difference() decimal.Number {
+	return e.Debit.Sub(e.Credit)
+}
+
+// Totals returns sums of debit and credit postings, every posting amount is
+// rounded using entry policy before it is added.
+func (e Entry) Totals() (debit, credit decimal.Number) {
+	debit = decimal.Round(decimal.Zero(), e.Policy.Exp, e.Policy.Rule)
+	credit = debit
+	for _, p := range e.Postings {
+		amount := decimal.Round(p.Amount, e.Policy.Exp, e.Policy.Rule)
+		if p.Side == Debit {
+			debit = debit.Add(amount)
+		} else {
+			credit = credit.Add(amount)
+		}
+	}
+	return debit, credit
+}
+
+// Balance checks that entry is valid and balanced. All postings must have a
+// known side, non-negative amount and the same currency, otherwise
+// decimal.ErrCurrencyMismatch is returned. Unbalanced entry returns
+// *ImbalanceError.
+func Balance(e Entry) error {
+	for i, p := range e.Postings {
+		if p.Side != Debit && p.Side != Credit {
+			return fmt.Errorf("ledger: posting %d: invalid side %s", i, p.Side)
+		}
+		if p.Amount.IsNegative() {
+			return fmt.Errorf("ledger: posting %d: negative amount %s", i, decimal.DebugString(p.Amount))
+		}
+		if p.Currency != e.Postings[0].Currency {
+			return fmt.Errorf("%w: posting %d: %s, expected %s", decimal.ErrCurrencyMismatch, i, p.Currency, e.Postings[0].Currency)
+		}
+	}
+
+	debit, credit := e.Totals()
+	if !debit.Equal(credit) {
+		return &ImbalanceError{Debit: debit, Credit: credit}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package ledger
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBalance(t *testing.T) {
+	e := Entry{
+		Postings: []Posting{
+			{Account: "wallet", Side: Debit, Amount: decimal.New(1000, -2), Currency: "EUR"},
+			{Account: "stakes", Side: Credit, Amount: decimal.New(750, -2), Currency: "EUR"},
+			{Account: "bonus", Side: Credit, Amount: decimal.New(25, -1), Currency: "EUR"},
+		},
+		Policy: Policy{Exp: -2, Rule: decimal.RoundBankers},
+	}
+	assert.NoError(t, Balance(e))
+
+	debit, credit := e.Totals()
+	assert.Equal(t, newDecimal.New(1000, -2), debit)
+	assert.Equal(t, newDecimal.New(1000, -2), credit)
+}
+
+func TestBalancePerEntryRounding(t *testing.T) {
+	postings := []Posting{
+		{Account: "a", Side: Debit, Amount: decimal.New(1, 0)},
+		{Account: "b", Side: Credit, Amount: decimal.New(3335, -4)},
+		{Account: "c", Side: Credit, Amount: decimal.New(3335, -4)},
+		{Account: "d", Side: Credit, Amount: decimal.New(333, -3)},
+	}
+
+	// 0.33 + 0.33 + 0.33 != 1.00
+	err := Balance(Entry{Postings: postings, Policy: Policy{Exp: -2, Rule: decimal.RoundTruncate}})
+	var imbalance *ImbalanceError
+	if assert.True(t, errors.As(err, &imbalance)) {
+		assert.Equal(t, newDecimal.New(100, -2), imbalance.Debit)
+		assert.Equal(t, newDecimal.New(99, -2), imbalance.Credit)
+		assert.Equal(t, newDecimal.New(1, -2), imbalance.Difference())
+		assert.Equal(t, `ledger: entry is not balanced: debit dec(coef=100, exp=-2, "1"), credit dec(coef=99, exp=-2, "0.99")`, err.Error())
+	}
+
+	// 0.334 + 0.334 + 0.333 != 1.000
+	err = Balance(Entry{Postings: postings, Policy: Policy{Exp: -3, Rule: decimal.RoundMath}})
+	assert.True(t, errors.As(err, &imbalance))
+
+	// 0.334 + 0.334 + 0.332 == 1.000
+	postings[3].Amount = decimal.New(332, -3)
+	assert.NoError(t, Balance(Entry{Postings: postings, Policy: Policy{Exp: -3, Rule: decimal.RoundBankers}}))
+}
+
+func TestBalanceInvalid(t *testing.T) {
+	policy := Policy{Exp: -2, Rule: decimal.RoundMath}
+
+	err := Balance(Entry{Postings: []Posting{
+		{Side: Debit, Amount: decimal.New(1, 0), Currency: "EUR"},
+		{Side: Credit, Amount: decimal.New(1, 0), Currency: "USD"},
+	}, Policy: policy})
+	assert.ErrorIs(t, err, decimal.ErrCurrencyMismatch)
+
+	err = Balance(Entry{Postings: []Posting{
+		{Side: Debit, Amount: decimal.New(-1, 0)},
+		{Side: Credit, Amount: decimal.New(-1, 0)},
+	}, Policy: policy})
+	assert.EqualError(t, err, `ledger: posting 0: negative amount dec(coef=-1, exp=0, "-1")`)
+
+	err = Balance(Entry{Postings: []Posting{{Side: Side(5), Amount: decimal.Zero()}}, Policy: policy})
+	assert.EqualError(t, err, "ledger: posting 0: invalid side Side(5)")
+
+	assert.NoError(t, Balance(Entry{Policy: policy}))
+}
@@ -0,0 +1,136 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// LimitViolation is enum type for specifying which limit was violated.
+type LimitViolation int
+
+// List of limit violations
+const (
+	StakeBelowMin     LimitViolation = iota // Stake is lower than MinStake
+	StakeAboveMax                           // Stake is higher than MaxStake
+	StakeNotIncrement                       // Stake is not a multiple of StakeIncrement
+	PayoutAboveMax                          // Potential payout is higher than MaxPayout
+)
+
+// String returns a short description of the violation.
+func (v LimitViolation) String() string {
+	switch v {
+	case StakeBelowMin:
+		return "stake below minimum"
+	case StakeAboveMax:
+		return "stake above maximum"
+	case StakeNotIncrement:
+		return "stake not a multiple of increment"
+	case PayoutAboveMax:
+		return "payout above maximum"
+	default:
+		return fmt.Sprintf("LimitViolation(%d)", int(v))
+	}
+}
+
+// LimitError describes a violated limit. It matches ErrLimit when checked
+// with errors.Is.
+type LimitError struct {
+	Violation LimitViolation // Violation is the violated limit
+	Limit     Number         // Limit is the configured limit value
+	Value     Number         // Value is the stake or payout that violated it
+	// Lower and Upper are the nearest valid values below and above Value,
+	// they are set by ValidateIncrement only. Lower is invalid if there is
+	// no valid value below Value.
+	Lower NullNumber
+	Upper NullNumber
+}
+
+func (e *LimitError) Error() string {
+	return "decimal: " + e.Violation.String() + ": " + e.Value.String() + " (limit " + e.Limit.String() + ")"
+}
+
+// Is reports whether the error matches ErrLimit.
+func (e *LimitError) Is(target error) bool {
+	return target == ErrLimit
+}
+
+// Limits holds stake and payout limits of a bet. Zero value of any limit
+// disables it.
+type Limits struct {
+	MinStake       Number
+	MaxStake       Number
+	MaxPayout      Number
+	StakeIncrement Number
+}
+
+// Check validates a stake placed at given odds against the limits. Limits are
+// checked in the order of LimitViolation constants and a *LimitError
+// describing the first violation is returned. Potential payout is the exact
+// product stake * odds.
+func (l Limits) Check(stake, odds Number) error {
+	if !l.MinStake.IsZero() && stake.Cmp(l.MinStake) < 0 {
+		return &LimitError{Violation: StakeBelowMin, Limit: l.MinStake, Value: stake}
+	}
+	if !l.MaxStake.IsZero() && stake.Cmp(l.MaxStake) > 0 {
+		return &LimitError{Violation: StakeAboveMax, Limit: l.MaxStake, Value: stake}
+	}
+	if !l.StakeIncrement.IsZero() && !stake.Mod(l.StakeIncrement).IsZero() {
+		return &LimitError{Violation: StakeNotIncrement, Limit: l.StakeIncrement, Value: stake}
+	}
+	if payout := stake.Mul(odds); !l.MaxPayout.IsZero() && payout.Cmp(l.MaxPayout) > 0 {
+		return &LimitError{Violation: PayoutAboveMax, Limit: l.MaxPayout, Value: payout}
+	}
+	return nil
+}
+
+// ValidateIncrement checks that n is at least min and an exact multiple of
+// increment above it, i.e. n is min + k * increment for some k >= 0, e.g.
+// stakes in 0.10 steps from 0.50. A *LimitError with StakeBelowMin or
+// StakeNotIncrement violation is returned otherwise, its Lower and Upper
+// fields hold the nearest valid values for hints, e.g. 0.60 and 0.70 for
+// 0.65. Zero increment disables the multiple check. It panics if increment
+// is negative.
+func ValidateIncrement(n, min, increment Number) error {
+	if increment.Sign() < 0 {
+		panic("decimal: negative increment " + DebugString(increment))
+	}
+	if n.Cmp(min) < 0 {
+		return &LimitError{
+			Violation: StakeBelowMin,
+			Limit:     min,
+			Value:     n,
+			Upper:     NullNumber{Decimal: min, Valid: true},
+		}
+	}
+	if increment.Sign() == 0 {
+		return nil
+	}
+	if r := remainder(n.Sub(min), increment); r.Sign() != 0 {
+		lower := n.Sub(r)
+		return &LimitError{
+			Violation: StakeNotIncrement,
+			Limit:     increment,
+			Value:     n,
+			Lower:     NullNumber{Decimal: lower, Valid: true},
+			Upper:     NullNumber{Decimal: lower.Add(increment), Valid: true},
+		}
+	}
+	return nil
+}
+
+// remainder calculates x mod y exactly for non-negative x and positive y.
+// Both operands are rescaled to the smaller exponent, so unlike Mod the
+// quotient is never rounded.
+func remainder(x, y Number) Number {
+	exp := x.Exponent()
+	if y.Exponent() < exp {
+		exp = y.Exponent()
+	}
+	xc, yc := x.Coefficient(), y.Coefficient()
+	xc.Mul(xc, pow10(int64(x.Exponent())-int64(exp)))
+	yc.Mul(yc, pow10(int64(y.Exponent())-int64(exp)))
+	_, r := new(big.Int).QuoRem(xc, yc, new(big.Int))
+	return newDecimal.NewFromBigInt(r, exp)
+}
@@ -0,0 +1,101 @@
+package decimal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitsCheck(t *testing.T) {
+	limits := Limits{
+		MinStake:       New(50, -2),
+		MaxStake:       New(1000, 0),
+		MaxPayout:      New(50000, 0),
+		StakeIncrement: New(1, -1),
+	}
+	tests := []struct {
+		stake     Number
+		odds      Number
+		violation LimitViolation
+		value     string
+		ok        bool
+	}{
+		{New(10, 0), New(2, 0), 0, "", true},
+		{New(50, -2), New(2, 0), 0, "", true},
+		{New(49, -2), New(2, 0), StakeBelowMin, "0.49", false},
+		{New(10001, -1), New(2, 0), StakeAboveMax, "1000.1", false},
+		{New(105, -2), New(2, 0), StakeNotIncrement, "1.05", false},
+		{New(1000, 0), New(50, 0), 0, "", true},
+		{New(1000, 0), New(5001, -2), PayoutAboveMax, "50010", false},
+	}
+
+	for _, test := range tests {
+		err := limits.Check(test.stake, test.odds)
+		if test.ok {
+			assert.NoError(t, err, test.stake.String())
+			continue
+		}
+
+		assert.ErrorIs(t, err, ErrLimit)
+		var limitErr *LimitError
+		assert.True(t, errors.As(err, &limitErr))
+		assert.Equal(t, test.violation, limitErr.Violation)
+		assert.Equal(t, test.value, limitErr.Value.String())
+	}
+
+	err := limits.Check(New(1, -2), New(2, 0))
+	assert.EqualError(t, err, "decimal: stake below minimum: 0.01 (limit 0.5)")
+	assert.NoError(t, Limits{}.Check(New(1, 9), New(1000, 0)))
+}
+
+func TestValidateIncrement(t *testing.T) {
+	min, increment := New(50, -2), New(10, -2)
+	tests := []struct {
+		n            string
+		violation    LimitViolation
+		lower, upper string
+		ok           bool
+	}{
+		{"0.50", 0, "", "", true},
+		{"0.6", 0, "", "", true},
+		{"12.30", 0, "", "", true},
+		{"0.65", StakeNotIncrement, "0.6", "0.7", false},
+		{"0.501", StakeNotIncrement, "0.5", "0.6", false},
+		{"10.99", StakeNotIncrement, "10.9", "11", false},
+		// remainder is exact, Mod would round the quotient to 0.8
+		{"0.7999999999999999999", StakeNotIncrement, "0.7", "0.8", false},
+		{"0.49", StakeBelowMin, "", "0.5", false},
+		{"-1", StakeBelowMin, "", "0.5", false},
+	}
+
+	for _, test := range tests {
+		err := ValidateIncrement(numbers(test.n)[0], min, increment)
+		if test.ok {
+			assert.NoError(t, err, test.n)
+			continue
+		}
+
+		assert.ErrorIs(t, err, ErrLimit, test.n)
+		var limitErr *LimitError
+		if !assert.True(t, errors.As(err, &limitErr), test.n) {
+			continue
+		}
+		assert.Equal(t, test.violation, limitErr.Violation, test.n)
+		assert.Equal(t, test.n, limitErr.Value.String(), test.n)
+		assert.Equal(t, test.lower != "", limitErr.Lower.Valid, test.n)
+		if limitErr.Lower.Valid {
+			assert.Equal(t, test.lower, limitErr.Lower.Decimal.String(), test.n)
+			assert.NoError(t, ValidateIncrement(limitErr.Lower.Decimal, min, increment), test.n)
+		}
+		assert.Equal(t, test.upper, limitErr.Upper.Decimal.String(), test.n)
+		assert.NoError(t, ValidateIncrement(limitErr.Upper.Decimal, min, increment), test.n)
+	}
+
+	// increment is counted from min
+	assert.NoError(t, ValidateIncrement(New(75, -2), New(25, -2), New(50, -2)))
+	assert.Error(t, ValidateIncrement(New(50, -2), New(25, -2), New(50, -2)))
+	assert.NoError(t, ValidateIncrement(New(1234, -3), min, Zero()))
+	assert.EqualError(t, ValidateIncrement(New(65, -2), min, increment), "decimal: stake not a multiple of increment: 0.65 (limit 0.1)")
+	assert.Panics(t, func() { _ = ValidateIncrement(One, min, New(-1, -1)) })
+}
@@ -0,0 +1,187 @@
+package decimal
+
+import (
+	"math"
+	"math/big"
+
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// MarginMethod is enum type for specifying how bookmaker margin is distributed
+// between the selections of a market.
+type MarginMethod int
+
+// List of supported margin methods
+const (
+	// MarginProportional distributes margin proportionally to implied
+	// probabilities, fair probability is 1/odds divided by the book sum.
+	MarginProportional MarginMethod = iota
+	// MarginEqual distributes margin equally, every implied probability is
+	// reduced by the same amount.
+	MarginEqual
+	// MarginPower raises implied probabilities to a common power k chosen so
+	// that they sum to 1, longer odds carry more margin.
+	MarginPower
+)
+
+// powerIterations is the number of bisection steps used to find the exponent
+// of MarginPower, enough to reach full float64 precision.
+const powerIterations = 200
+
+// RemoveMargin derives fair odds from quoted decimal odds of all selections of
+// a market, every fair price is rounded once to the given exponent using the
+// given rounding rule. Proportional and equal methods are calculated exactly.
+// Power method finds the exponent numerically in float64 precision, its
+// results are accurate to about 15 significant digits.
+//
+// ErrInvalidOdds is returned if there are less than two selections, any odds
+// are not greater than 1 or the equal method produces a non-positive
+// probability.
+func RemoveMargin(odds []Number, method MarginMethod, exp int, rule RoundRule) ([]Number, error) {
+	if len(odds) < 2 {
+		return nil, ErrInvalidOdds
+	}
+
+	// implied probabilities and their sum (the book)
+	probs := make([]*big.Rat, len(odds))
+	book := new(big.Rat)
+	for i, o := range odds {
+		if o.Cmp(One) <= 0 {
+			return nil, ErrInvalidOdds
+		}
+		probs[i] = new(big.Rat).Inv(o.Rat())
+		book.Add(book, probs[i])
+	}
+
+	res := make([]Number, len(odds))
+	switch method {
+	case MarginEqual:
+		// p = 1/odds - (book - 1) / n
+		margin := new(big.Rat).Sub(book, big.NewRat(1, 1))
+		margin.Quo(margin, big.NewRat(int64(len(odds)), 1))
+		for i, p := range probs {
+			p.Sub(p, margin)
+			if p.Sign() <= 0 {
+				return nil, ErrInvalidOdds
+			}
+			res[i] = ratRound(p.Inv(p), exp, rule)
+		}
+	case MarginPower:
+		floats := make([]float64, len(probs))
+		for i, p := range probs {
+			floats[i], _ = p.Float64()
+		}
+		k := powerExponent(floats, 1)
+		for i, p := range floats {
+			res[i] = Round(newDecimal.NewFromFloat(math.Pow(p, -k)), exp, rule)
+		}
+	default:
+		// fair odds = odds * book
+		for i, o := range odds {
+			res[i] = ratRound(new(big.Rat).Mul(o.Rat(), book), exp, rule)
+		}
+	}
+
+	return res, nil
+}
+
+// ApplyMargin derives quoted decimal odds from fair odds of all selections of
+// a market, so that implied probabilities of quoted odds sum to
+// targetOverround, e.g. 1.05 for 5% margin. It is the inverse of RemoveMargin
+// using the same method, removing margin from unrounded quoted odds gives back
+// the fair odds. Implied probabilities of fair odds need not sum to exactly 1,
+// e.g. after rounding, the quoted odds still have the target overround. Every
+// quoted price is rounded once to the given exponent using the given rounding
+// rule, precision of the methods is the same as in RemoveMargin.
+//
+// ErrInvalidOdds is returned if there are less than two selections, any fair
+// odds are not greater than 1, targetOverround is below 1 or any quoted odds
+// would not be greater than 1.
+func ApplyMargin(fairOdds []Number, targetOverround Number, method MarginMethod, exp int, rule RoundRule) ([]Number, error) {
+	if len(fairOdds) < 2 || targetOverround.Cmp(One) < 0 {
+		return nil, ErrInvalidOdds
+	}
+
+	// fair probabilities and their sum
+	probs := make([]*big.Rat, len(fairOdds))
+	book := new(big.Rat)
+	for i, o := range fairOdds {
+		if o.Cmp(One) <= 0 {
+			return nil, ErrInvalidOdds
+		}
+		probs[i] = new(big.Rat).Inv(o.Rat())
+		book.Add(book, probs[i])
+	}
+	target := targetOverround.Rat()
+
+	res := make([]Number, len(fairOdds))
+	switch method {
+	case MarginEqual:
+		// p = 1/fair + (target - book) / n
+		margin := new(big.Rat).Sub(target, book)
+		margin.Quo(margin, big.NewRat(int64(len(fairOdds)), 1))
+		for i, p := range probs {
+			p.Add(p, margin)
+			if p.Sign() <= 0 || p.Cmp(big.NewRat(1, 1)) >= 0 {
+				return nil, ErrInvalidOdds
+			}
+			res[i] = ratRound(p.Inv(p), exp, rule)
+		}
+	case MarginPower:
+		floats := make([]float64, len(probs))
+		for i, p := range probs {
+			floats[i], _ = p.Float64()
+		}
+		t, _ := target.Float64()
+		if t >= float64(len(floats)) {
+			return nil, ErrInvalidOdds
+		}
+		k := powerExponent(floats, t)
+		for i, p := range floats {
+			res[i] = Round(newDecimal.NewFromFloat(math.Pow(p, -k)), exp, rule)
+		}
+	default:
+		// quoted odds = fair * book / target
+		for i, o := range fairOdds {
+			q := new(big.Rat).Mul(o.Rat(), book)
+			q.Quo(q, target)
+			if q.Cmp(big.NewRat(1, 1)) <= 0 {
+				return nil, ErrInvalidOdds
+			}
+			res[i] = ratRound(q, exp, rule)
+		}
+	}
+
+	return res, nil
+}
+
+// powerExponent finds k such that sum of probs[i]^k is target using
+// bisection. All probabilities must be between 0 and 1 exclusive, target
+// must be below len(probs).
+func powerExponent(probs []float64, target float64) float64 {
+	sum := func(k float64) float64 {
+		s := 0.0
+		for _, p := range probs {
+			s += math.Pow(p, k)
+		}
+		return s
+	}
+
+	// sum is decreasing in k and sum(0) = len(probs) > target
+	lo, hi := 0.0, 1.0
+	for sum(hi) > target {
+		lo, hi = hi, hi*2
+	}
+	for i := 0; i < powerIterations; i++ {
+		mid := (lo + hi) / 2
+		if mid == lo || mid == hi {
+			break
+		}
+		if sum(mid) > target {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
@@ -0,0 +1,102 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveMargin(t *testing.T) {
+	odds := numbers("2.5", "3.2", "3.0")
+	tests := []struct {
+		method   MarginMethod
+		rule     RoundRule
+		expected []string
+	}{
+		{MarginProportional, RoundBankers, []string{"2.6146", "3.3467", "3.1375"}},
+		{MarginProportional, RoundTruncate, []string{"2.6145", "3.3466", "3.1375"}},
+		{MarginEqual, RoundBankers, []string{"2.5993", "3.3645", "3.1441"}},
+		{MarginPower, RoundBankers, []string{"2.5999", "3.3632", "3.1443"}},
+	}
+
+	for _, test := range tests {
+		fair, err := RemoveMargin(odds, test.method, -4, test.rule)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, numberStrings(fair), "method %d", test.method)
+	}
+}
+
+func TestRemoveMarginTwoWay(t *testing.T) {
+	for _, method := range []MarginMethod{MarginProportional, MarginEqual, MarginPower} {
+		fair, err := RemoveMargin(numbers("1.90", "1.90"), method, -2, RoundBankers)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2", "2"}, numberStrings(fair), "method %d", method)
+	}
+}
+
+func TestRemoveMarginErrors(t *testing.T) {
+	_, err := RemoveMargin(numbers("1.5"), MarginProportional, -2, RoundBankers)
+	assert.ErrorIs(t, err, ErrInvalidOdds)
+	_, err = RemoveMargin(numbers("1.5", "1"), MarginProportional, -2, RoundBankers)
+	assert.ErrorIs(t, err, ErrInvalidOdds)
+	// margin larger than the implied probability of the outsider
+	_, err = RemoveMargin(numbers("1.01", "1.5", "40"), MarginEqual, -2, RoundBankers)
+	assert.ErrorIs(t, err, ErrInvalidOdds)
+}
+
+func TestApplyMargin(t *testing.T) {
+	fair := numbers("2", "4", "4")
+	tests := []struct {
+		method   MarginMethod
+		expected []string
+	}{
+		{MarginProportional, []string{"1.9048", "3.8095", "3.8095"}},
+		{MarginEqual, []string{"1.9355", "3.75", "3.75"}},
+		{MarginPower, []string{"1.9362", "3.7487", "3.7487"}},
+	}
+
+	for _, test := range tests {
+		quoted, err := ApplyMargin(fair, New(105, -2), test.method, -4, RoundBankers)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, numberStrings(quoted), "method %d", test.method)
+	}
+}
+
+func TestApplyMarginInverse(t *testing.T) {
+	// implied probabilities 0.4 + 0.5 + 0.25 = 1.15
+	quoted := numbers("2.5", "2", "4")
+	for _, method := range []MarginMethod{MarginProportional, MarginEqual, MarginPower} {
+		fair, err := RemoveMargin(quoted, method, -12, RoundBankers)
+		assert.NoError(t, err)
+		requoted, err := ApplyMargin(fair, New(115, -2), method, -6, RoundBankers)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2.5", "2", "4"}, numberStrings(requoted), "method %d", method)
+
+		quoted, err := ApplyMargin(numbers("2", "4", "4"), New(105, -2), method, -12, RoundBankers)
+		assert.NoError(t, err)
+		fair, err = RemoveMargin(quoted, method, -6, RoundBankers)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2", "4", "4"}, numberStrings(fair), "method %d", method)
+	}
+}
+
+func TestApplyMarginErrors(t *testing.T) {
+	tests := []struct {
+		fair   []Number
+		target string
+		method MarginMethod
+	}{
+		{numbers("2"), "1.05", MarginProportional},
+		{numbers("2", "1"), "1.05", MarginEqual},
+		{numbers("2", "2"), "0.99", MarginPower},
+		// quoted odds of the favourite would not be greater than 1
+		{numbers("1.05", "21"), "1.2", MarginProportional},
+		{numbers("1.05", "21"), "1.2", MarginEqual},
+		{numbers("2", "2"), "2", MarginPower},
+	}
+
+	for _, test := range tests {
+		_, err := ApplyMargin(test.fair, numbers(test.target)[0], test.method, -2, RoundBankers)
+		assert.ErrorIs(t, err, ErrInvalidOdds, "%v %s", numberStrings(test.fair), test.target)
+	}
+}
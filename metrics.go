@@ -0,0 +1,35 @@
+package decimal
+
+import (
+	"math/big"
+)
+
+// ObserveFloat converts n to the nearest float64 for float-based monitoring
+// systems. The second return value is true if the float64 holds exactly the
+// value of n, e.g. 0.5 converts exactly but 0.1 does not. Numbers beyond the
+// float64 range convert to infinity and are reported as inexact.
+// Uninitialized Number{} is treated as zero.
+func ObserveFloat(n Number) (float64, bool) {
+	f := n.InexactFloat64()
+	r := new(big.Rat).SetFloat64(f)
+	if r == nil {
+		return f, false
+	}
+	return f, r.Cmp(n.Rat()) == 0
+}
+
+// Label returns string representation of n suitable as a metrics label
+// value. Numerically equal numbers have the same label regardless of their
+// exponent, e.g. both 1.5 and 1.50 are labeled "1.5". Uninitialized Number{}
+// is treated as zero.
+func Label(n Number) string {
+	return String(n)
+}
+
+// LabelRounded returns metrics label of n rounded to the given exponent using
+// the given rounding rule. Rounding bounds number of distinct label values,
+// e.g. stakes rounded to exponent 1 produce one label per ten units of
+// currency.
+func LabelRounded(n Number, exp int, rule RoundRule) string {
+	return Label(Round(n, exp, rule))
+}
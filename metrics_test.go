@@ -0,0 +1,52 @@
+package decimal
+
+import (
+	"math"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObserveFloat(t *testing.T) {
+	tests := []struct {
+		n     string
+		f     float64
+		exact bool
+	}{
+		{"0", 0, true},
+		{"0.00", 0, true},
+		{"1", 1, true},
+		{"-12.5", -12.5, true},
+		{"0.5", 0.5, true},
+		{"0.1", 0.1, false},
+		{"0.30", 0.3, false},
+		{"9007199254740992", 9007199254740992, true},
+		{"9007199254740993", 9007199254740992, false},
+		{"0.000000000000000000000000000000000000000000000001", 1e-48, false},
+		{"1e400", math.Inf(1), false},
+		{"-1e400", math.Inf(-1), false},
+	}
+
+	for _, test := range tests {
+		f, exact := ObserveFloat(newDecimal.RequireFromString(test.n))
+		assert.Equal(t, test.f, f, test.n)
+		assert.Equal(t, test.exact, exact, test.n)
+	}
+
+	f, exact := ObserveFloat(Number{})
+	assert.Equal(t, 0.0, f)
+	assert.True(t, exact)
+}
+
+func TestLabel(t *testing.T) {
+	assert.Equal(t, "1.5", Label(New(15, -1)))
+	assert.Equal(t, "1.5", Label(New(1500, -3)))
+	assert.Equal(t, "1000", Label(New(1, 3)))
+	assert.Equal(t, "0", Label(New(0, -2)))
+	assert.Equal(t, "0", Label(Number{}))
+
+	assert.Equal(t, "120", LabelRounded(New(12345, -2), 1, RoundMath))
+	assert.Equal(t, "123.4", LabelRounded(New(12345, -2), -1, RoundBankers))
+	assert.Equal(t, "123", LabelRounded(New(12300, -2), -1, RoundBankers))
+}
@@ -0,0 +1,70 @@
+package decimal
+
+// MovingAvg calculates average of the last N pushed values. The rolling sum is
+// kept exact, rounding is only performed when the average is requested, so
+// the result does not drift no matter how many values were pushed.
+type MovingAvg struct {
+	window []Number
+	next   int
+	full   bool
+	sum    Number
+}
+
+// NewMovingAvg creates a moving average over a window of the given size. It
+// panics if size is not positive.
+func NewMovingAvg(size int) *MovingAvg {
+	if size <= 0 {
+		panic("decimal: moving average window size must be positive")
+	}
+	return &MovingAvg{
+		window: make([]Number, size),
+		sum:    Zero(),
+	}
+}
+
+// Push adds n to the window, the oldest value is dropped if the window is
+// full.
+func (m *MovingAvg) Push(n Number) {
+	if m.full {
+		m.sum = m.sum.Sub(m.window[m.next])
+	}
+	m.window[m.next] = n
+	m.sum = m.sum.Add(n)
+
+	m.next++
+	if m.next == len(m.window) {
+		m.next = 0
+		m.full = true
+	}
+}
+
+// Len returns number of values in the window.
+func (m *MovingAvg) Len() int {
+	if m.full {
+		return len(m.window)
+	}
+	return m.next
+}
+
+// Sum returns exact sum of values in the window.
+func (m *MovingAvg) Sum() Number {
+	return m.sum
+}
+
+// Value returns average of values in the window rounded to the given exponent
+// using the given rounding rule. Until the window is filled, the average of
+// values pushed so far is returned. Average of an empty window is zero.
+func (m *MovingAvg) Value(exp int, rule RoundRule) Number {
+	if m.Len() == 0 {
+		return Round(Zero(), exp, rule)
+	}
+	return divRound(m.sum, New(int64(m.Len()), 0), exp, rule)
+}
+
+// Reset removes all values from the window.
+func (m *MovingAvg) Reset() {
+	for i := range m.window {
+		m.window[i] = Number{}
+	}
+	m.next, m.full, m.sum = 0, false, Zero()
+}
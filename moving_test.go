@@ -0,0 +1,51 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMovingAvg(t *testing.T) {
+	m := NewMovingAvg(3)
+	assert.Equal(t, 0, m.Len())
+	assert.Equal(t, New(0, -2), m.Value(-2, RoundBankers))
+
+	tests := []struct {
+		push     Number
+		len      int
+		sum      string
+		expected string
+	}{
+		{New(1, 0), 1, "1", "1"},
+		{New(2, 0), 2, "3", "1.5"},
+		{New(2, 0), 3, "5", "1.67"},
+		{New(-4, 0), 3, "0", "0"},
+		{New(101, -2), 3, "-0.99", "-0.33"},
+		{New(3, 0), 3, "0.01", "0"},
+	}
+
+	for _, test := range tests {
+		m.Push(test.push)
+		assert.Equal(t, test.len, m.Len())
+		assert.Equal(t, test.sum, m.Sum().String())
+		assert.Equal(t, test.expected, m.Value(-2, RoundBankers).String())
+	}
+
+	m.Reset()
+	assert.Equal(t, 0, m.Len())
+	assert.True(t, m.Sum().IsZero())
+	m.Push(New(5, 0))
+	assert.Equal(t, "5", m.Value(0, RoundBankers).String())
+
+	assert.Panics(t, func() { NewMovingAvg(0) })
+}
+
+func TestMovingAvgNoDrift(t *testing.T) {
+	m := NewMovingAvg(10)
+	for i := 0; i < 100000; i++ {
+		m.Push(New(1, -1))
+	}
+	assert.True(t, m.Sum().Equal(New(1, 0)))
+	assert.Equal(t, New(100, -3), m.Value(-3, RoundBankers))
+}
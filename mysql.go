@@ -0,0 +1,128 @@
+package decimal
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// MySQL DECIMAL type limits.
+const (
+	MySQLMaxPrecision = 65 // Total number of digits
+	MySQLMaxScale     = 30 // Number of fractional digits
+)
+
+// MySQLDecimal describes MySQL DECIMAL(Precision, Scale) column type, also
+// reported as NEWDECIMAL by the MySQL protocol. It converts numbers to query
+// parameters and scans column values of go-sql-driver/mysql, which uses
+// []byte for DECIMAL columns in both text and binary protocols.
+type MySQLDecimal struct {
+	Precision int
+	Scale     int
+}
+
+// MySQLDecimalFor returns the smallest DECIMAL type holding n without
+// rounding. Trailing zeros of the coefficient are not counted. It fails with
+// ErrPrecisionLoss if n has more than 30 fractional digits and ErrOverflow if
+// n has more than 65 digits in total.
+func MySQLDecimalFor(n Number) (MySQLDecimal, error) {
+	digits, exp := SignificantDigits(n)
+	scale := int64(0)
+	if exp < 0 {
+		scale = -exp
+	}
+	precision := digits + exp
+	if precision < 0 {
+		precision = 0
+	}
+	precision += scale
+	if precision == 0 {
+		precision = 1
+	}
+
+	if scale > MySQLMaxScale {
+		return MySQLDecimal{}, fmt.Errorf("%w: %s has more than %d fractional digits", ErrPrecisionLoss, DebugString(n), MySQLMaxScale)
+	}
+	if precision > MySQLMaxPrecision {
+		return MySQLDecimal{}, fmt.Errorf("%w: %s does not fit DECIMAL(%d,%d)", ErrOverflow, DebugString(n), MySQLMaxPrecision, scale)
+	}
+	return MySQLDecimal{Precision: int(precision), Scale: int(scale)}, nil
+}
+
+// MySQLDecimalOf returns DECIMAL type of a result set column. The second
+// return value is false if the column is not DECIMAL or its driver does not
+// report precision and scale.
+func MySQLDecimalOf(ct *sql.ColumnType) (MySQLDecimal, bool) {
+	switch strings.ToUpper(ct.DatabaseTypeName()) {
+	case "DECIMAL", "NEWDECIMAL", "UNSIGNED DECIMAL":
+	default:
+		return MySQLDecimal{}, false
+	}
+	precision, scale, ok := ct.DecimalSize()
+	if !ok {
+		return MySQLDecimal{}, false
+	}
+	return MySQLDecimal{Precision: int(precision), Scale: int(scale)}, true
+}
+
+// String returns SQL definition of the type, e.g. "DECIMAL(10,2)".
+func (t MySQLDecimal) String() string {
+	return fmt.Sprintf("DECIMAL(%d,%d)", t.Precision, t.Scale)
+}
+
+// Check checks if n can be stored in the column without rounding or
+// overflow. MySQL would silently round numbers having more fractional digits
+// than Scale and clip numbers too large for Precision, Check fails with
+// ErrPrecisionLoss and ErrOverflow instead. It panics if the type is not a
+// valid DECIMAL type.
+func (t MySQLDecimal) Check(n Number) error {
+	t.validate()
+	return fitsNumeric(n, t.Precision, t.Scale)
+}
+
+// Value returns query parameter for n, a string having exactly Scale
+// fractional digits, e.g. "12.30" for DECIMAL(10,2). It fails like Check.
+func (t MySQLDecimal) Value(n Number) (driver.Value, error) {
+	if err := t.Check(n); err != nil {
+		return nil, err
+	}
+	return n.StringFixed(int32(t.Scale)), nil
+}
+
+// Scan converts column value to a decimal number having exponent -Scale, so
+// scanned numbers have stable exponents regardless of the protocol. Text,
+// integer and nil values are accepted, NULL fails with ErrNull. Byte slices
+// are not retained. It panics if the type is not a valid DECIMAL type.
+func (t MySQLDecimal) Scan(value interface{}) (Number, error) {
+	t.validate()
+
+	var n Number
+	var err error
+	switch v := value.(type) {
+	case nil:
+		return Number{}, ErrNull
+	case []byte:
+		n, err = FromBytes(v)
+	case string:
+		n, err = FromString(v)
+	default:
+		var ok bool
+		if n, ok = scanInteger(value); !ok {
+			return Number{}, fmt.Errorf("decimal: can't scan %T into %s", value, t)
+		}
+	}
+	if err != nil {
+		return Number{}, err
+	}
+	if err := fitsNumeric(n, t.Precision, t.Scale); err != nil {
+		return Number{}, err
+	}
+	return rescaleExact(n, -t.Scale)
+}
+
+func (t MySQLDecimal) validate() {
+	if t.Precision < 1 || t.Precision > MySQLMaxPrecision || t.Scale < 0 || t.Scale > MySQLMaxScale || t.Scale > t.Precision {
+		panic("decimal: invalid MySQL type " + t.String())
+	}
+}
@@ -0,0 +1,184 @@
+package decimal
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// mysqlDriver is a database driver returning a single DECIMAL column the way
+// go-sql-driver/mysql does, values are []byte having exactly scale
+// fractional digits.
+type mysqlDriver struct {
+	typ    MySQLDecimal
+	values []driver.Value
+}
+
+func (d mysqlDriver) Open(string) (driver.Conn, error) { return mysqlConn{d}, nil }
+
+type mysqlConn struct{ d mysqlDriver }
+
+func (c mysqlConn) Prepare(string) (driver.Stmt, error) { return mysqlStmt(c), nil }
+func (c mysqlConn) Close() error                        { return nil }
+func (c mysqlConn) Begin() (driver.Tx, error)           { return nil, driver.ErrSkip }
+
+type mysqlStmt struct{ d mysqlDriver }
+
+func (s mysqlStmt) Close() error                               { return nil }
+func (s mysqlStmt) NumInput() int                              { return 0 }
+func (s mysqlStmt) Exec([]driver.Value) (driver.Result, error) { return nil, driver.ErrSkip }
+func (s mysqlStmt) Query([]driver.Value) (driver.Rows, error)  { return &mysqlRows{d: s.d}, nil }
+
+type mysqlRows struct {
+	d mysqlDriver
+	i int
+}
+
+func (r *mysqlRows) Columns() []string                     { return []string{"amount"} }
+func (r *mysqlRows) Close() error                          { return nil }
+func (r *mysqlRows) ColumnTypeDatabaseTypeName(int) string { return "DECIMAL" }
+func (r *mysqlRows) ColumnTypePrecisionScale(int) (int64, int64, bool) {
+	return int64(r.d.typ.Precision), int64(r.d.typ.Scale), true
+}
+func (r *mysqlRows) Next(dest []driver.Value) error {
+	if r.i == len(r.d.values) {
+		return io.EOF
+	}
+	dest[0] = r.d.values[r.i]
+	r.i++
+	return nil
+}
+
+func TestMySQLDecimalFor(t *testing.T) {
+	tests := []struct {
+		n        Number
+		expected MySQLDecimal
+		err      error
+	}{
+		{New(1234, -2), MySQLDecimal{4, 2}, nil},
+		{New(12340, -3), MySQLDecimal{4, 2}, nil},
+		{New(-5, -3), MySQLDecimal{3, 3}, nil},
+		{New(5, 3), MySQLDecimal{4, 0}, nil},
+		{Number{}, MySQLDecimal{1, 0}, nil},
+		{New(1, -30), MySQLDecimal{30, 30}, nil},
+		{New(1, -31), MySQLDecimal{}, ErrPrecisionLoss},
+		{New(1, 64), MySQLDecimal{65, 0}, nil},
+		{New(1, 65), MySQLDecimal{}, ErrOverflow},
+		{New(1, 2147483647), MySQLDecimal{}, ErrOverflow},
+	}
+
+	for _, test := range tests {
+		actual, err := MySQLDecimalFor(test.n)
+		assert.ErrorIs(t, err, test.err, DebugString(test.n))
+		assert.Equal(t, test.expected, actual, DebugString(test.n))
+		if err == nil {
+			assert.NoError(t, actual.Check(test.n))
+		}
+	}
+}
+
+func TestMySQLDecimalValueScan(t *testing.T) {
+	max := newDecimal.RequireFromString(strings.Repeat("9", 35) + "." + strings.Repeat("9", 30))
+	tests := []struct {
+		typ   MySQLDecimal
+		n     Number
+		value string
+	}{
+		{MySQLDecimal{10, 2}, New(123, -1), "12.30"},
+		{MySQLDecimal{10, 2}, New(-5, 0), "-5.00"},
+		{MySQLDecimal{10, 0}, New(42, 0), "42"},
+		{MySQLDecimal{65, 30}, max, max.String()},
+		{MySQLDecimal{65, 30}, max.Neg(), max.Neg().String()},
+		{MySQLDecimal{65, 0}, newDecimal.RequireFromString(strings.Repeat("9", 65)), strings.Repeat("9", 65)},
+	}
+
+	for _, test := range tests {
+		v, err := test.typ.Value(test.n)
+		assert.NoError(t, err, test.typ.String())
+		assert.Equal(t, test.value, v, test.typ.String())
+
+		n, err := test.typ.Scan([]byte(test.value))
+		assert.NoError(t, err, test.typ.String())
+		assert.True(t, n.Equal(test.n), test.typ.String())
+		assert.Equal(t, int32(-test.typ.Scale), n.Exponent(), test.typ.String())
+	}
+}
+
+func TestMySQLDecimalScan(t *testing.T) {
+	typ := MySQLDecimal{10, 2}
+	for _, v := range []interface{}{[]byte("12.3"), "12.30", int64(12), uint64(12), int32(12), big.NewInt(12)} {
+		n, err := typ.Scan(v)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(-2), n.Exponent())
+	}
+
+	_, err := typ.Scan(nil)
+	assert.ErrorIs(t, err, ErrNull)
+	_, err = typ.Scan([]byte("1.234"))
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	_, err = typ.Scan([]byte("123456789"))
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = typ.Scan(uint64(math.MaxUint64))
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = typ.Scan([]byte("x"))
+	assert.ErrorIs(t, err, ErrParse)
+	_, err = typ.Scan(1.5)
+	assert.EqualError(t, err, "decimal: can't scan float64 into DECIMAL(10,2)")
+}
+
+func TestMySQLDecimalCheck(t *testing.T) {
+	typ := MySQLDecimal{5, 2}
+	assert.NoError(t, typ.Check(New(99999, -2)))
+	assert.ErrorIs(t, typ.Check(New(1, 3)), ErrOverflow)
+	assert.ErrorIs(t, typ.Check(New(1, -3)), ErrPrecisionLoss)
+	_, err := typ.Value(New(1, -3))
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	for _, invalid := range []MySQLDecimal{{0, 0}, {66, 0}, {10, 31}, {2, 3}, {10, -1}} {
+		assert.Panics(t, func() { _ = invalid.Check(One) }, invalid.String())
+		assert.Panics(t, func() { _, _ = invalid.Scan("1") }, invalid.String())
+	}
+}
+
+func TestMySQLDecimalColumn(t *testing.T) {
+	typ := MySQLDecimal{65, 30}
+	max := strings.Repeat("9", 35) + "." + strings.Repeat("9", 30)
+	sql.Register("decimal-mysql", mysqlDriver{typ: typ, values: []driver.Value{
+		[]byte("0.000000000000000000000000000000"),
+		[]byte(max),
+		[]byte("-" + max),
+	}})
+	db, err := sql.Open("decimal-mysql", "")
+	assert.NoError(t, err)
+	defer db.Close()
+	rows, err := db.Query("")
+	assert.NoError(t, err)
+	defer rows.Close()
+
+	cts, err := rows.ColumnTypes()
+	assert.NoError(t, err)
+	actual, ok := MySQLDecimalOf(cts[0])
+	assert.True(t, ok)
+	assert.Equal(t, typ, actual)
+	assert.Equal(t, "DECIMAL(65,30)", actual.String())
+
+	var scanned []string
+	for rows.Next() {
+		var raw sql.RawBytes
+		assert.NoError(t, rows.Scan(&raw))
+		n, err := actual.Scan([]byte(raw))
+		assert.NoError(t, err)
+		v, err := actual.Value(n)
+		assert.NoError(t, err)
+		scanned = append(scanned, v.(string))
+	}
+	assert.NoError(t, rows.Err())
+	assert.Equal(t, []string{"0.000000000000000000000000000000", max, "-" + max}, scanned)
+}
@@ -0,0 +1,129 @@
+package decimal
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// Numbers is a list of decimal numbers. All comparisons are numeric, numbers
+// with different exponents but equal values (e.g. 1.0 and 1.00) are considered
+// equal.
+type Numbers []Number
+
+// Sum returns exact sum of all numbers, zero for an empty list.
+func (ns Numbers) Sum() Number {
+	return sumExact(ns)
+}
+
+// Min returns the smallest number in the list, zero for an empty list.
+func (ns Numbers) Min() Number {
+	if len(ns) == 0 {
+		return Zero()
+	}
+	min := ns[0]
+	for _, n := range ns[1:] {
+		if n.Cmp(min) < 0 {
+			min = n
+		}
+	}
+	return min
+}
+
+// Max returns the largest number in the list, zero for an empty list.
+func (ns Numbers) Max() Number {
+	if len(ns) == 0 {
+		return Zero()
+	}
+	max := ns[0]
+	for _, n := range ns[1:] {
+		if n.Cmp(max) > 0 {
+			max = n
+		}
+	}
+	return max
+}
+
+// SortAsc sorts the list in place in ascending order. Order of equal numbers
+// is preserved.
+func (ns Numbers) SortAsc() {
+	Sort(ns)
+}
+
+// SortDesc sorts the list in place in descending order. Order of equal
+// numbers is preserved.
+func (ns Numbers) SortDesc() {
+	SortDesc(ns)
+}
+
+// Sort sorts values in place in ascending order. Order of equal numbers is
+// preserved, uninitialized Number{} is treated as zero.
+func Sort(values []Number) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return Cmp(values[i], values[j]) < 0
+	})
+}
+
+// SortDesc sorts values in place in descending order. Order of equal numbers
+// is preserved, uninitialized Number{} is treated as zero.
+func SortDesc(values []Number) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return Cmp(values[i], values[j]) > 0
+	})
+}
+
+// Contains checks if the list has a number equal to n.
+func (ns Numbers) Contains(n Number) bool {
+	for _, v := range ns {
+		if v.Equal(n) {
+			return true
+		}
+	}
+	return false
+}
+
+// Equal checks if both lists have the same length and equal numbers at every
+// position.
+func (ns Numbers) Equal(other Numbers) bool {
+	if len(ns) != len(other) {
+		return false
+	}
+	for i := range ns {
+		if !ns[i].Equal(other[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// MarshalJSON implements the json.Marshaler interface. Nil list is marshaled
+// as an empty JSON array.
+func (ns Numbers) MarshalJSON() ([]byte, error) {
+	buf := make([]byte, 0, 2+len(ns)*appendBufSize/2)
+	buf = append(buf, '[')
+	for i, n := range ns {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = AppendJSON(buf, n)
+	}
+	return append(buf, ']'), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (ns *Numbers) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	list := make([]Number, len(raw))
+	for i, elem := range raw {
+		if err := unmarshalJSON(&list[i], elem); err != nil {
+			return err
+		}
+	}
+	if raw == nil {
+		list = nil
+	}
+	*ns = list
+	return nil
+}
@@ -0,0 +1,86 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumbersAggregates(t *testing.T) {
+	ns := Numbers{New(150, -2), New(-3, 0), New(25, -1), New(0, 0)}
+
+	assert.Equal(t, newDecimal.New(100, -2), ns.Sum())
+	assert.Equal(t, newDecimal.New(-3, 0), ns.Min())
+	assert.Equal(t, newDecimal.New(25, -1), ns.Max())
+
+	var empty Numbers
+	assert.True(t, empty.Sum().IsZero())
+	assert.True(t, empty.Min().IsZero())
+	assert.True(t, empty.Max().IsZero())
+}
+
+func TestNumbersSort(t *testing.T) {
+	ns := Numbers{New(2, 0), New(10, -1), New(-1, 0), New(1, 0), New(3, 0)}
+
+	ns.SortAsc()
+	assert.Equal(t, Numbers{New(-1, 0), New(10, -1), New(1, 0), New(2, 0), New(3, 0)}, ns)
+
+	ns.SortDesc()
+	assert.Equal(t, Numbers{New(3, 0), New(2, 0), New(10, -1), New(1, 0), New(-1, 0)}, ns)
+}
+
+func TestSort(t *testing.T) {
+	values := []Number{New(3, 0), {}, New(-1, 0), New(10, -1), New(0, 2), New(1, 0)}
+
+	Sort(values)
+	assert.Equal(t, []string{"-1", "0", "0", "1", "1", "3"}, numberStrings(values))
+	assert.Equal(t, New(10, -1), values[3], "stable order")
+
+	SortDesc(values)
+	assert.Equal(t, []string{"3", "1", "1", "0", "0", "-1"}, numberStrings(values))
+	assert.Equal(t, New(10, -1), values[1], "stable order")
+}
+
+func numberStrings(values []Number) []string {
+	res := make([]string, len(values))
+	for i, v := range values {
+		res[i] = v.String()
+	}
+	return res
+}
+
+func TestNumbersContainsEqual(t *testing.T) {
+	ns := Numbers{New(0, -2), New(15, -1)}
+
+	assert.True(t, ns.Contains(Zero()))
+	assert.True(t, ns.Contains(New(150, -2)))
+	assert.False(t, ns.Contains(New(1, 0)))
+
+	assert.True(t, ns.Equal(Numbers{Zero(), New(150, -2)}))
+	assert.False(t, ns.Equal(Numbers{New(15, -1), Zero()}))
+	assert.False(t, ns.Equal(Numbers{Zero()}))
+	assert.True(t, Numbers(nil).Equal(Numbers{}))
+}
+
+func TestNumbersJSON(t *testing.T) {
+	blob, err := json.Marshal(Numbers{New(15, -1), New(-2, 0)})
+	assert.NoError(t, err)
+	assert.Equal(t, `[1.5,-2]`, string(blob))
+
+	blob, err = json.Marshal(struct {
+		List Numbers `json:"list"`
+	}{})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"list":[]}`, string(blob))
+
+	var ns Numbers
+	assert.NoError(t, json.Unmarshal([]byte(`[1.5, "2.25"]`), &ns))
+	assert.Equal(t, Numbers{newDecimal.New(15, -1), newDecimal.New(225, -2)}, ns)
+
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &ns))
+	assert.Nil(t, ns)
+
+	assert.Error(t, json.Unmarshal([]byte(`[1.5, "x"]`), &ns))
+}
@@ -0,0 +1,121 @@
+package decimal
+
+import (
+	"math/big"
+)
+
+// standardFractions is the list of fractional odds conventionally quoted by
+// bookmakers, sorted in ascending order. Some of them are deliberately not
+// reduced, e.g. 4/6 is quoted instead of 2/3.
+var standardFractions = [][2]int64{
+	{1, 100}, {1, 50}, {1, 33}, {1, 25}, {1, 20}, {1, 16}, {1, 14}, {1, 12},
+	{1, 10}, {1, 9}, {1, 8}, {2, 15}, {1, 7}, {2, 13}, {1, 6}, {2, 11},
+	{1, 5}, {2, 9}, {1, 4}, {2, 7}, {3, 10}, {1, 3}, {4, 11}, {2, 5},
+	{4, 9}, {1, 2}, {8, 15}, {4, 7}, {8, 13}, {4, 6}, {8, 11}, {4, 5},
+	{5, 6}, {10, 11}, {1, 1}, {21, 20}, {11, 10}, {6, 5}, {5, 4}, {11, 8},
+	{6, 4}, {8, 5}, {13, 8}, {7, 4}, {15, 8}, {2, 1}, {9, 4}, {5, 2},
+	{11, 4}, {3, 1}, {10, 3}, {7, 2}, {4, 1}, {9, 2}, {5, 1}, {11, 2},
+	{6, 1}, {13, 2}, {7, 1}, {15, 2}, {8, 1}, {17, 2}, {9, 1}, {10, 1},
+	{11, 1}, {12, 1}, {14, 1}, {16, 1}, {18, 1}, {20, 1}, {22, 1}, {25, 1},
+	{28, 1}, {33, 1}, {40, 1}, {50, 1}, {66, 1}, {80, 1}, {100, 1}, {150, 1},
+	{200, 1}, {250, 1}, {500, 1}, {1000, 1},
+}
+
+// ToFractionalOdds converts decimal odds to exact fractional odds in the
+// lowest terms, e.g. 2.5 is converted to 3/2 and 1.53 to 53/100. Decimal odds
+// must be greater than 1, otherwise ErrInvalidOdds is returned. ErrOverflow is
+// returned if the fraction terms do not fit into int64.
+func ToFractionalOdds(decimalOdds Number) (num, den int64, err error) {
+	if decimalOdds.Cmp(One) <= 0 {
+		return 0, 0, ErrInvalidOdds
+	}
+
+	r := decimalOdds.Sub(One).Rat()
+	if !r.Num().IsInt64() || !r.Denom().IsInt64() {
+		return 0, 0, ErrOverflow
+	}
+	return r.Num().Int64(), r.Denom().Int64(), nil
+}
+
+// ToStandardFractionalOdds converts decimal odds to the nearest fractional
+// odds conventionally quoted by bookmakers, e.g. 1.53 is converted to 8/15.
+// On a tie the shorter odds are returned. Decimal odds must be greater than 1,
+// otherwise ErrInvalidOdds is returned.
+func ToStandardFractionalOdds(decimalOdds Number) (num, den int64, err error) {
+	if decimalOdds.Cmp(One) <= 0 {
+		return 0, 0, ErrInvalidOdds
+	}
+
+	target := decimalOdds.Sub(One).Rat()
+	var best, diff, frac big.Rat
+	for i, f := range standardFractions {
+		frac.SetFrac64(f[0], f[1])
+		diff.Sub(&frac, target)
+		diff.Abs(&diff)
+		if i == 0 || diff.Cmp(&best) < 0 {
+			best.Set(&diff)
+			num, den = f[0], f[1]
+		}
+	}
+	return num, den, nil
+}
+
+// FromFractionalOdds converts fractional odds num/den to decimal odds 1 +
+// num/den rounded to the given exponent using the given rounding rule. It
+// panics if den is zero.
+func FromFractionalOdds(num, den int64, exp int, rule RoundRule) Number {
+	return divRound(New(num+den, 0), New(den, 0), exp, rule)
+}
+
+// ToAmericanOdds converts decimal odds to American (moneyline) odds. Odds of
+// 2 and above are converted to positive values (d - 1) * 100, shorter odds to
+// negative values -100 / (d - 1). Even odds 2.0 are converted to +100. The
+// result is rounded to the nearest integer, ties are rounded away from zero.
+// Decimal odds must be greater than 1, otherwise ErrInvalidOdds is returned.
+func ToAmericanOdds(decimalOdds Number) (int64, error) {
+	if decimalOdds.Cmp(One) <= 0 {
+		return 0, ErrInvalidOdds
+	}
+
+	var american Number
+	if decimalOdds.Cmp(Two) >= 0 {
+		american = Round(decimalOdds.Sub(One).Shift(2), 0, RoundMath)
+	} else {
+		american = divRound(New(-100, 0), decimalOdds.Sub(One), 0, RoundMath)
+	}
+
+	if !american.Coefficient().IsInt64() {
+		return 0, ErrOverflow
+	}
+	return american.IntPart(), nil
+}
+
+// FromAmericanOdds converts American (moneyline) odds to decimal odds rounded
+// to the given exponent using the given rounding rule. Positive odds a are
+// converted to 1 + a/100, negative odds to 1 + 100/|a|, so both +100 and -100
+// are even odds 2.0. It panics if odds are between -100 and +100 exclusive,
+// such American odds do not exist.
+func FromAmericanOdds(american int64, exp int, rule RoundRule) Number {
+	switch {
+	case american >= 100:
+		return Round(New(american, -2).Add(One), exp, rule)
+	case american <= -100:
+		// 1 + 100/|a| = (|a| + 100) / |a|
+		abs := New(american, 0).Neg()
+		return divRound(abs.Add(Hundred), abs, exp, rule)
+	default:
+		panic("decimal: American odds must not be between -100 and +100")
+	}
+}
+
+// AccumulatorOdds calculates combined decimal odds of an accumulator (parlay)
+// bet, i.e. product of odds of all legs. The product is calculated exactly and
+// rounded once to the given exponent using the given rounding rule. Odds of an
+// accumulator with no legs are 1.
+func AccumulatorOdds(legs []Number, exp int, rule RoundRule) Number {
+	odds := One
+	for _, leg := range legs {
+		odds = odds.Mul(leg)
+	}
+	return Round(odds, exp, rule)
+}
@@ -0,0 +1,149 @@
+package decimal
+
+import (
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToFractionalOdds(t *testing.T) {
+	tests := []struct {
+		odds string
+		num  int64
+		den  int64
+	}{
+		{"2", 1, 1},
+		{"2.5", 3, 2},
+		{"1.53", 53, 100},
+		{"1.6666", 3333, 5000},
+		{"11", 10, 1},
+		{"1.001", 1, 1000},
+	}
+
+	for _, test := range tests {
+		num, den, err := ToFractionalOdds(newDecimal.RequireFromString(test.odds))
+		assert.NoError(t, err, test.odds)
+		assert.Equal(t, [2]int64{test.num, test.den}, [2]int64{num, den}, test.odds)
+	}
+
+	for _, odds := range []Number{One, Zero(), New(-2, 0)} {
+		_, _, err := ToFractionalOdds(odds)
+		assert.ErrorIs(t, err, ErrInvalidOdds)
+	}
+
+	_, _, err := ToFractionalOdds(newDecimal.RequireFromString("1.0000000000000000000001"))
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestToStandardFractionalOdds(t *testing.T) {
+	tests := []struct {
+		odds string
+		num  int64
+		den  int64
+	}{
+		{"1.53", 8, 15},
+		{"1.67", 4, 6},
+		{"1.66", 4, 6},
+		{"2", 1, 1},
+		{"2.05", 21, 20},
+		{"2.62", 13, 8},
+		{"3.75", 11, 4},
+		{"1.001", 1, 100},
+		{"5000", 1000, 1},
+		{"1.909", 10, 11},
+	}
+
+	for _, test := range tests {
+		num, den, err := ToStandardFractionalOdds(newDecimal.RequireFromString(test.odds))
+		assert.NoError(t, err, test.odds)
+		assert.Equal(t, [2]int64{test.num, test.den}, [2]int64{num, den}, test.odds)
+	}
+
+	_, _, err := ToStandardFractionalOdds(One)
+	assert.ErrorIs(t, err, ErrInvalidOdds)
+}
+
+func TestFromFractionalOdds(t *testing.T) {
+	assert.Equal(t, "1.53", FromFractionalOdds(8, 15, -2, RoundBankers).String())
+	assert.Equal(t, "1.533", FromFractionalOdds(8, 15, -3, RoundTruncate).String())
+	assert.Equal(t, "1.67", FromFractionalOdds(4, 6, -2, RoundBankers).String())
+	assert.Equal(t, "1.66", FromFractionalOdds(4, 6, -2, RoundTruncate).String())
+	assert.Equal(t, "11", FromFractionalOdds(10, 1, -2, RoundBankers).String())
+	assert.Panics(t, func() { FromFractionalOdds(1, 0, -2, RoundBankers) })
+}
+
+func TestStandardFractionsSorted(t *testing.T) {
+	for i := 1; i < len(standardFractions); i++ {
+		prev, cur := standardFractions[i-1], standardFractions[i]
+		assert.Less(t, prev[0]*cur[1], cur[0]*prev[1], "%v before %v", prev, cur)
+	}
+}
+
+func TestToAmericanOdds(t *testing.T) {
+	tests := []struct {
+		odds     string
+		american int64
+	}{
+		{"2", 100},
+		{"2.00", 100},
+		{"3.5", 250},
+		{"2.015", 102},
+		{"2.005", 101},
+		{"1.5", -200},
+		{"1.91", -110},
+		{"1.909", -110},
+		{"1.995", -101},
+		{"1.999", -100},
+		{"1.01", -10000},
+		{"1001", 100000},
+	}
+
+	for _, test := range tests {
+		american, err := ToAmericanOdds(newDecimal.RequireFromString(test.odds))
+		assert.NoError(t, err, test.odds)
+		assert.Equal(t, test.american, american, test.odds)
+	}
+
+	_, err := ToAmericanOdds(One)
+	assert.ErrorIs(t, err, ErrInvalidOdds)
+	_, err = ToAmericanOdds(New(1, 30))
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestFromAmericanOdds(t *testing.T) {
+	tests := []struct {
+		american int64
+		rule     RoundRule
+		odds     string
+	}{
+		{100, RoundBankers, "2"},
+		{-100, RoundBankers, "2"},
+		{250, RoundBankers, "3.5"},
+		{-200, RoundBankers, "1.5"},
+		{-110, RoundBankers, "1.91"},
+		{-110, RoundTruncate, "1.9"},
+		{-150, RoundCeil, "1.67"},
+		{-150, RoundBankers, "1.67"},
+		{-300, RoundFloor, "1.33"},
+		{155, RoundBankers, "2.55"},
+		{-10000, RoundBankers, "1.01"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.odds, FromAmericanOdds(test.american, -2, test.rule).String(), "%d", test.american)
+	}
+
+	assert.Panics(t, func() { FromAmericanOdds(99, -2, RoundBankers) })
+	assert.Panics(t, func() { FromAmericanOdds(-99, -2, RoundBankers) })
+	assert.Panics(t, func() { FromAmericanOdds(0, -2, RoundBankers) })
+}
+
+func TestAccumulatorOdds(t *testing.T) {
+	legs := []Number{New(15, -1), New(185, -2), New(2101, -3)}
+
+	assert.Equal(t, "5.83", AccumulatorOdds(legs, -2, RoundBankers).String())
+	assert.Equal(t, "5.83", AccumulatorOdds(legs, -2, RoundTruncate).String())
+	assert.Equal(t, "5.830275", AccumulatorOdds(legs, -6, RoundBankers).String())
+	assert.Equal(t, "1", AccumulatorOdds(nil, -2, RoundBankers).String())
+}
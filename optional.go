@@ -0,0 +1,117 @@
+package decimal
+
+import (
+	"database/sql/driver"
+)
+
+// Optional is a decimal number that distinguishes three states: absent (field
+// was not set at all), null and a value. Unlike NullNumber, it can tell apart
+// JSON object fields that are missing from fields that are explicitly set to
+// null, which is required for PATCH-style requests. Zero value is absent.
+type Optional struct {
+	Number  Number
+	Valid   bool // Valid is true if Number is set, i.e. value is not null
+	Present bool // Present is true if value was set, even to null
+}
+
+// OptionalValue creates present non-null optional value.
+func OptionalValue(n Number) Optional {
+	return Optional{Number: n, Valid: true, Present: true}
+}
+
+// OptionalNull creates present optional value set to null.
+func OptionalNull() Optional {
+	return Optional{Present: true}
+}
+
+// IsNull returns true if value is present and set to null.
+func (o Optional) IsNull() bool {
+	return o.Present && !o.Valid
+}
+
+// Get returns number and true if value is present and not null.
+func (o Optional) Get() (Number, bool) {
+	if !o.Present || !o.Valid {
+		return Number{}, false
+	}
+	return o.Number, true
+}
+
+// MarshalJSON implements the json.Marshaler interface. Both absent and null
+// values are marshaled as JSON null.
+func (o Optional) MarshalJSON() ([]byte, error) {
+	if !o.Present || !o.Valid {
+		return []byte("null"), nil
+	}
+	return AppendJSON(make([]byte, 0, appendBufSize), o.Number), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It is only called
+// for fields present in the JSON input, so any input marks value as present.
+// On failure the value is left unchanged.
+func (o *Optional) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = Optional{Present: true}
+		return nil
+	}
+	var n Number
+	if err := unmarshalJSON(&n, data); err != nil {
+		return err
+	}
+	*o = OptionalValue(n)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. Both absent
+// and null values are marshaled as empty text.
+func (o Optional) MarshalText() ([]byte, error) {
+	if !o.Present || !o.Valid {
+		return []byte{}, nil
+	}
+	return AppendText(make([]byte, 0, appendBufSize), o.Number), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Empty text
+// is unmarshaled as null value. On failure the value is left unchanged.
+func (o *Optional) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = Optional{Present: true}
+		return nil
+	}
+	var n Number
+	if err := n.UnmarshalText(text); err != nil {
+		return err
+	}
+	if err := checkBounds(n); err != nil {
+		return err
+	}
+	*o = OptionalValue(n)
+	return nil
+}
+
+// Scan implements the sql.Scanner interface for database deserialization.
+// Integers of any size, e.g. uint64 or *big.Int, are converted exactly.
+func (o *Optional) Scan(value interface{}) error {
+	if value == nil {
+		*o = Optional{Present: true}
+		return nil
+	}
+	n, err := scanValue(value)
+	if err != nil {
+		return err
+	}
+	if err := checkBounds(n); err != nil {
+		return err
+	}
+	*o = OptionalValue(n)
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database serialization.
+// Both absent and null values are stored as NULL.
+func (o Optional) Value() (driver.Value, error) {
+	if !o.Present || !o.Valid {
+		return nil, nil
+	}
+	return o.Number.Value()
+}
@@ -0,0 +1,85 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionalStates(t *testing.T) {
+	var absent Optional
+	_, ok := absent.Get()
+	assert.False(t, ok)
+	assert.False(t, absent.IsNull())
+
+	null := OptionalNull()
+	_, ok = null.Get()
+	assert.False(t, ok)
+	assert.True(t, null.IsNull())
+
+	zero := OptionalValue(Zero())
+	n, ok := zero.Get()
+	assert.True(t, ok)
+	assert.True(t, n.IsZero())
+	assert.False(t, zero.IsNull())
+}
+
+func TestOptionalUnmarshalJSON(t *testing.T) {
+	type patch struct {
+		Stake Optional `json:"stake"`
+		Limit Optional `json:"limit"`
+		Bonus Optional `json:"bonus"`
+	}
+
+	var p patch
+	err := json.Unmarshal([]byte(`{"stake": 12.5, "limit": null}`), &p)
+	assert.NoError(t, err)
+
+	assert.Equal(t, OptionalValue(newDecimal.New(125, -1)), p.Stake)
+	assert.Equal(t, OptionalNull(), p.Limit)
+	assert.Equal(t, Optional{}, p.Bonus)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"stake": "x"}`), &p))
+}
+
+func TestOptionalMarshalJSON(t *testing.T) {
+	blob, err := json.Marshal([]Optional{{}, OptionalNull(), OptionalValue(New(125, -1))})
+	assert.NoError(t, err)
+	assert.Equal(t, `[null,null,12.5]`, string(blob))
+}
+
+func TestOptionalText(t *testing.T) {
+	var o Optional
+	assert.NoError(t, o.UnmarshalText([]byte("")))
+	assert.Equal(t, OptionalNull(), o)
+
+	assert.NoError(t, o.UnmarshalText([]byte("1.5")))
+	assert.Equal(t, OptionalValue(newDecimal.New(15, -1)), o)
+
+	text, err := o.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("1.5"), text)
+
+	text, err = OptionalNull().MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{}, text)
+}
+
+func TestOptionalSQL(t *testing.T) {
+	var o Optional
+	assert.NoError(t, o.Scan(nil))
+	assert.Equal(t, OptionalNull(), o)
+
+	assert.NoError(t, o.Scan([]byte("0.015")))
+	assert.Equal(t, OptionalValue(newDecimal.New(15, -3)), o)
+
+	val, err := o.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "0.015", val)
+
+	val, err = Optional{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, val)
+}
@@ -0,0 +1,52 @@
+package decimal
+
+import (
+	"fmt"
+)
+
+// Oracle NUMBER type limits.
+const (
+	OracleNumberPrecision = 38   // Number of significant digits
+	OracleNumberMaxExp    = 125  // Exponent of the largest number 9.99...9 * 10^125
+	OracleNumberMinExp    = -130 // Exponent of the smallest number 1 * 10^-130
+)
+
+// CheckOracleNumber checks if n can be stored in an Oracle NUMBER column
+// without rounding. It fails with ErrPrecisionLoss if n has more than 38
+// significant digits or is too close to zero and would be stored as zero, and
+// with ErrOverflow if |n| >= 10^126.
+func CheckOracleNumber(n Number) error {
+	digits, exp := SignificantDigits(n)
+	if digits == 0 {
+		return nil
+	}
+	if digits > OracleNumberPrecision {
+		return fmt.Errorf("%w: %s has more than %d significant digits", ErrPrecisionLoss, DebugString(n), OracleNumberPrecision)
+	}
+	// exponent of the most significant digit
+	lead := digits + exp - 1
+	if lead > OracleNumberMaxExp {
+		return fmt.Errorf("%w: %s does not fit Oracle NUMBER", ErrOverflow, DebugString(n))
+	}
+	if lead < OracleNumberMinExp {
+		return fmt.Errorf("%w: %s is below Oracle NUMBER resolution", ErrPrecisionLoss, DebugString(n))
+	}
+	return nil
+}
+
+// ToOracleNumber converts n to a plain decimal string without exponent
+// accepted by godror.Number and TO_NUMBER. It fails like CheckOracleNumber if
+// n does not fit the column.
+func ToOracleNumber(n Number) (string, error) {
+	if err := CheckOracleNumber(n); err != nil {
+		return "", err
+	}
+	return String(n), nil
+}
+
+// FromOracleNumber parses a NUMBER value returned by the database, e.g. a
+// godror.Number. Oracle formats omitting the leading zero, e.g. "-.5", and
+// scientific notation are accepted. Returned error is a *ParseError.
+func FromOracleNumber(str string) (Number, error) {
+	return FromString(str)
+}
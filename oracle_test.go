@@ -0,0 +1,65 @@
+package decimal
+
+import (
+	"strings"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckOracleNumber(t *testing.T) {
+	tests := []struct {
+		n   Number
+		err error
+	}{
+		{New(1234, -2), nil},
+		{newDecimal.RequireFromString("12345678901234567890.123456789012345678"), nil},
+		{newDecimal.RequireFromString("12345678901234567890.1234567890123456789"), ErrPrecisionLoss},
+		{New(123, 300), ErrOverflow},
+		{New(999, 123), nil},
+		{New(-999, 123), nil},
+		{New(1, 126), ErrOverflow},
+		{New(1, -130), nil},
+		{New(15, -131), nil},
+		{New(9, -131), ErrPrecisionLoss},
+		{New(1, 2147483647), ErrOverflow},
+		{New(1, -2147483648), ErrPrecisionLoss},
+		{New(10000, -4), nil},
+		{Number{}, nil},
+	}
+
+	for _, test := range tests {
+		err := CheckOracleNumber(test.n)
+		if test.err == nil {
+			assert.NoError(t, err, DebugString(test.n))
+		} else {
+			assert.ErrorIs(t, err, test.err, DebugString(test.n))
+		}
+	}
+}
+
+func TestOracleNumberConversions(t *testing.T) {
+	s, err := ToOracleNumber(New(-5, -1))
+	assert.NoError(t, err)
+	assert.Equal(t, "-0.5", s)
+	s, err = ToOracleNumber(New(1, 125))
+	assert.NoError(t, err)
+	assert.Equal(t, "1"+strings.Repeat("0", 125), s)
+	_, err = ToOracleNumber(New(1, 126))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	for input, expected := range map[string]Number{
+		"-.5":     New(-5, -1),
+		".25":     New(25, -2),
+		"1E+125":  New(1, 125),
+		"1.5E-10": New(15, -11),
+		"42":      New(42, 0),
+	} {
+		n, err := FromOracleNumber(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, n, input)
+	}
+	_, err = FromOracleNumber("~")
+	assert.ErrorIs(t, err, ErrParse)
+}
@@ -0,0 +1,54 @@
+package decimal
+
+import (
+	"runtime"
+	"sync"
+)
+
+// ProcessParallel applies fn to every value and returns results in the same
+// order. Values are split into contiguous chunks processed by the given number
+// of goroutines, non-positive workers count selects runtime.GOMAXPROCS(0).
+// fn must be safe for concurrent use.
+func ProcessParallel(values []Number, fn func(Number) Number, workers int) []Number {
+	res := make([]Number, len(values))
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(values) {
+		workers = len(values)
+	}
+	if workers <= 1 {
+		for i, v := range values {
+			res[i] = fn(v)
+		}
+		return res
+	}
+
+	var wg sync.WaitGroup
+	chunk := (len(values) + workers - 1) / workers
+	for start := 0; start < len(values); start += chunk {
+		end := start + chunk
+		if end > len(values) {
+			end = len(values)
+		}
+
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			for i := start; i < end; i++ {
+				res[i] = fn(values[i])
+			}
+		}(start, end)
+	}
+	wg.Wait()
+
+	return res
+}
+
+// RoundParallel rounds every value to the given exponent using the given
+// rounding rule, see ProcessParallel for the meaning of workers.
+func RoundParallel(values []Number, exp int, rule RoundRule, workers int) []Number {
+	return ProcessParallel(values, func(n Number) Number {
+		return Round(n, exp, rule)
+	}, workers)
+}
@@ -0,0 +1,45 @@
+package decimal
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessParallel(t *testing.T) {
+	values := make([]Number, 1001)
+	for i := range values {
+		values[i] = New(int64(i), -1)
+	}
+	double := func(n Number) Number { return MulInt(n, 2) }
+
+	for _, workers := range []int{-1, 0, 1, 3, 8, 2000} {
+		res := ProcessParallel(values, double, workers)
+		assert.Len(t, res, len(values))
+		for i := range values {
+			assert.Equal(t, New(int64(2*i), -1), res[i], fmt.Sprintf("workers %d, index %d", workers, i))
+		}
+	}
+
+	assert.Empty(t, ProcessParallel(nil, double, 4))
+}
+
+func TestRoundParallel(t *testing.T) {
+	values := []Number{New(1234, -3), New(-1235, -3), New(5, -3)}
+	res := RoundParallel(values, -2, RoundBankers, 2)
+
+	assert.Equal(t, []Number{New(123, -2), New(-124, -2), Round(New(5, -3), -2, RoundBankers)}, res)
+}
+
+func BenchmarkRoundParallel(b *testing.B) {
+	values := make([]Number, 100000)
+	for i := range values {
+		values[i] = New(int64(i), -4)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = RoundParallel(values, -2, RoundBankers, 0)
+	}
+}
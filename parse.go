@@ -0,0 +1,107 @@
+package decimal
+
+import (
+	"math"
+	"math/big"
+
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// FromBytes creates a new instance of decimal number by parsing given byte
+// slice, e.g. sql.RawBytes of a scanned column. Plain decimal numbers are
+// parsed in a single pass without intermediate strings, numbers with up to 19
+// digits do not use big.Int arithmetic. Other inputs are parsed exactly like
+// FromString does. Returned error is a *ParseError. The byte slice is not
+// retained.
+func FromBytes(b []byte) (Number, error) {
+	var scratch parseScratch
+	if n, ok := parsePlain(b, &scratch); ok {
+		return n, nil
+	}
+
+	str := string(b)
+	n, err := newDecimal.NewFromString(str)
+	if err != nil {
+		return Number{}, &ParseError{Input: str, Err: err}
+	}
+	return n, nil
+}
+
+// parseScratch is a reusable big.Int storage for parsing numbers having more
+// than 19 digits.
+type parseScratch struct {
+	coef big.Int
+	tmp  big.Int
+}
+
+// parsePlain parses numbers matching [+-]?[0-9]*(\.[0-9]*)? having at least
+// one digit. It returns false for any other input. Returned number does not
+// reference the scratch space.
+func parsePlain(b []byte, s *parseScratch) (Number, bool) {
+	if len(b) > math.MaxInt32 {
+		return Number{}, false
+	}
+
+	i, neg := 0, false
+	if len(b) > 0 && (b[0] == '-' || b[0] == '+') {
+		neg = b[0] == '-'
+		i++
+	}
+
+	var (
+		acc    uint64   // digits not yet added to coef
+		accLen int      // number of digits in acc
+		coef   *big.Int // used only for more than 19 digits
+		digits int
+		point  = -1
+	)
+	for ; i < len(b); i++ {
+		c := b[i]
+		switch {
+		case c >= '0' && c <= '9':
+			if accLen == 19 {
+				coef = addDigits(coef, s, acc, accLen)
+				acc, accLen = 0, 0
+			}
+			acc = acc*10 + uint64(c-'0')
+			accLen++
+			digits++
+		case c == '.' && point == -1:
+			point = digits
+		default:
+			return Number{}, false
+		}
+	}
+	if digits == 0 {
+		return Number{}, false
+	}
+
+	exp := 0
+	if point != -1 {
+		exp = point - digits
+	}
+
+	if coef == nil && acc <= math.MaxInt64 {
+		v := int64(acc)
+		if neg {
+			v = -v
+		}
+		return newDecimal.New(v, int32(exp)), true
+	}
+
+	coef = addDigits(coef, s, acc, accLen)
+	if neg {
+		coef.Neg(coef)
+	}
+	return newDecimal.NewFromBigInt(coef, int32(exp)), true
+}
+
+// addDigits returns coef * 10^n + acc, using the scratch coefficient if coef is
+// nil.
+func addDigits(coef *big.Int, s *parseScratch, acc uint64, n int) *big.Int {
+	if coef == nil {
+		return s.coef.SetUint64(acc)
+	}
+	coef.Mul(coef, pow10(int64(n)))
+	return coef.Add(coef, s.tmp.SetUint64(acc))
+}
@@ -0,0 +1,81 @@
+package decimal
+
+import (
+	"fmt"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromBytes(t *testing.T) {
+	inputs := []string{
+		"0", "-0", "00", "+0", "0.00", "-0.0000000", ".0", "1.", "-1.", ".2", "-.4", "+1", "+1.2",
+		"1234", "-12.34", "0.0012340", "123456789012345678", "-123456789012345678",
+		"1234567890123456789", "-1234567890123456789", "9223372036854775807",
+		"-9223372036854775808", "9223372036854775808", "9999999999999999999",
+		"12345678901234567890", "-1234567890.1234567890123456789012345678901234567890",
+		"0.000000000000000000000000000000000000000001",
+		"1e3", "1.5E-2", ".-2",
+	}
+
+	for _, in := range inputs {
+		expected, err := newDecimal.NewFromString(in)
+		assert.NoError(t, err, in)
+
+		actual, err := FromBytes([]byte(in))
+		assert.NoError(t, err, in)
+		assert.True(t, expected.Equal(actual), fmt.Sprintf("%s parsed as %s", in, actual))
+		assert.Equal(t, expected.Exponent(), actual.Exponent(), in)
+		if !expected.IsZero() {
+			assert.Equal(t, expected, actual, in)
+		}
+
+		actual, err = FromString(in)
+		assert.NoError(t, err, in)
+		assert.True(t, expected.Equal(actual), fmt.Sprintf("%s parsed as %s", in, actual))
+	}
+}
+
+func TestFromBytesInvalid(t *testing.T) {
+	inputs := []string{"", "-", "+", ".", "-.", " 1", "1 ", "1,2", "1.+2", "--1", "1.-2", "a1", "1.2.3", "1e", "0x10"}
+
+	for _, in := range inputs {
+		_, err := FromBytes([]byte(in))
+		assert.ErrorIs(t, err, ErrParse, in)
+		_, err = FromString(in)
+		assert.ErrorIs(t, err, ErrParse, in)
+	}
+}
+
+func BenchmarkFromBytes(b *testing.B) {
+	in := []byte("123456789.123456789")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = FromBytes(in)
+	}
+}
+
+func BenchmarkFromBytesLong(b *testing.B) {
+	in := []byte("1234567890.1234567890123456789012345678901234567890")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = FromBytes(in)
+	}
+}
+
+func BenchmarkExternalFromBytes(b *testing.B) {
+	in := []byte("123456789.123456789")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = newDecimal.NewFromString(string(in))
+	}
+}
+
+func BenchmarkExternalFromBytesLong(b *testing.B) {
+	in := []byte("1234567890.1234567890123456789012345678901234567890")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = newDecimal.NewFromString(string(in))
+	}
+}
@@ -0,0 +1,35 @@
+package decimal
+
+// PayoutPolicy holds market specific settlement rules.
+type PayoutPolicy struct {
+	Exp  int       // Exp is the exponent payouts are rounded to
+	Rule RoundRule // Rule is the rounding rule used for payouts
+	// Cap is the maximum payout, zero disables the cap.
+	Cap Number
+}
+
+// Payout calculates return of a winning bet stake * odds. The exact product is
+// rounded once according to the policy and limited to the policy cap.
+func Payout(stake, odds Number, policy PayoutPolicy) Number {
+	return policy.limit(Round(stake.Mul(odds), policy.Exp, policy.Rule))
+}
+
+// NetWinnings calculates profit of a winning bet, i.e. payout without the
+// stake.
+func NetWinnings(stake, odds Number, policy PayoutPolicy) Number {
+	return Payout(stake, odds, policy).Sub(stake)
+}
+
+// Refund calculates return of a void bet, i.e. the stake rounded according to
+// the policy. Refunds are not limited by the cap.
+func Refund(stake Number, policy PayoutPolicy) Number {
+	return Round(stake, policy.Exp, policy.Rule)
+}
+
+// limit applies the payout cap to n.
+func (p PayoutPolicy) limit(n Number) Number {
+	if p.Cap.IsPositive() && n.Cmp(p.Cap) > 0 {
+		return Round(p.Cap, p.Exp, RoundTruncate)
+	}
+	return n
+}
@@ -0,0 +1,44 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayout(t *testing.T) {
+	policy := PayoutPolicy{Exp: -2, Rule: RoundTruncate}
+	tests := []struct {
+		stake  Number
+		odds   Number
+		rule   RoundRule
+		payout string
+		net    string
+	}{
+		{New(1000, -2), New(185, -2), RoundTruncate, "18.5", "8.5"},
+		{New(333, -2), New(1777, -3), RoundTruncate, "5.91", "2.58"},
+		{New(333, -2), New(1777, -3), RoundBankers, "5.92", "2.59"},
+		{New(5, 0), New(1, 0), RoundBankers, "5", "0"},
+	}
+
+	for _, test := range tests {
+		policy.Rule = test.rule
+		assert.Equal(t, test.payout, Payout(test.stake, test.odds, policy).String())
+		assert.Equal(t, test.net, NetWinnings(test.stake, test.odds, policy).String())
+	}
+}
+
+func TestPayoutCap(t *testing.T) {
+	policy := PayoutPolicy{Exp: -2, Rule: RoundBankers, Cap: New(10000, 0)}
+
+	assert.Equal(t, "10000", Payout(New(1000, 0), New(101, 0), policy).String())
+	assert.Equal(t, int32(-2), Payout(New(1000, 0), New(101, 0), policy).Exponent())
+	assert.Equal(t, "9000", NetWinnings(New(1000, 0), New(101, 0), policy).String())
+	assert.Equal(t, "9999.9", Payout(New(99999, -1), New(1, 0), policy).String())
+	assert.Equal(t, "20000", Refund(New(20000, 0), policy).String())
+}
+
+func TestRefund(t *testing.T) {
+	assert.Equal(t, New(1235, -2), Refund(New(12345, -3), PayoutPolicy{Exp: -2, Rule: RoundMath}))
+	assert.Equal(t, New(1234, -2), Refund(New(12345, -3), PayoutPolicy{Exp: -2, Rule: RoundBankers}))
+}
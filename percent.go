@@ -0,0 +1,153 @@
+package decimal
+
+import (
+	"bytes"
+)
+
+// Percent is a decimal number expressed in percent, i.e. Percent value 5
+// represents factor 0.05. Text and JSON representations hold the number of
+// percents without the factor conversion.
+type Percent struct {
+	n Number
+}
+
+// BasisPoints is a decimal number expressed in basis points, i.e. BasisPoints
+// value 5 represents factor 0.0005. Text and JSON representations hold the
+// number of basis points without the factor conversion.
+type BasisPoints struct {
+	n Number
+}
+
+// NewPercent creates percent value from a number of percents.
+func NewPercent(n Number) Percent {
+	return Percent{n: n}
+}
+
+// PercentFromFactor creates percent value from a plain factor, e.g. factor
+// 0.05 is 5%.
+func PercentFromFactor(f Number) Percent {
+	return Percent{n: f.Shift(2)}
+}
+
+// Number returns number of percents.
+func (p Percent) Number() Number {
+	return p.n
+}
+
+// Factor returns plain factor represented by the percent value, e.g. 5% is
+// factor 0.05.
+func (p Percent) Factor() Number {
+	return p.n.Shift(-2)
+}
+
+// BasisPoints converts percent value to basis points.
+func (p Percent) BasisPoints() BasisPoints {
+	return BasisPoints{n: p.n.Shift(2)}
+}
+
+// ApplyTo calculates n * percent and rounds the result to the given exponent
+// using the given rounding rule.
+func (p Percent) ApplyTo(n Number, exp int, rule RoundRule) Number {
+	return Round(n.Mul(p.Factor()), exp, rule)
+}
+
+// String returns percent value formatted with a percent sign, e.g. "5%".
+func (p Percent) String() string {
+	return String(p.n) + "%"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (p Percent) MarshalText() ([]byte, error) {
+	return AppendText(make([]byte, 0, appendBufSize), p.n), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Optional
+// trailing percent sign is accepted.
+func (p *Percent) UnmarshalText(text []byte) error {
+	n, err := FromString(string(bytes.TrimSuffix(text, []byte("%"))))
+	if err != nil {
+		return err
+	}
+	if err := checkBounds(n); err != nil {
+		return err
+	}
+	p.n = n
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p Percent) MarshalJSON() ([]byte, error) {
+	return AppendJSON(make([]byte, 0, appendBufSize), p.n), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *Percent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(&p.n, data)
+}
+
+// NewBasisPoints creates basis points value from a number of basis points.
+func NewBasisPoints(n Number) BasisPoints {
+	return BasisPoints{n: n}
+}
+
+// BasisPointsFromFactor creates basis points value from a plain factor, e.g.
+// factor 0.0005 is 5 basis points.
+func BasisPointsFromFactor(f Number) BasisPoints {
+	return BasisPoints{n: f.Shift(4)}
+}
+
+// Number returns number of basis points.
+func (b BasisPoints) Number() Number {
+	return b.n
+}
+
+// Factor returns plain factor represented by the basis points value, e.g. 5
+// basis points is factor 0.0005.
+func (b BasisPoints) Factor() Number {
+	return b.n.Shift(-4)
+}
+
+// Percent converts basis points value to percent.
+func (b BasisPoints) Percent() Percent {
+	return Percent{n: b.n.Shift(-2)}
+}
+
+// ApplyTo calculates n * basis points and rounds the result to the given
+// exponent using the given rounding rule.
+func (b BasisPoints) ApplyTo(n Number, exp int, rule RoundRule) Number {
+	return Round(n.Mul(b.Factor()), exp, rule)
+}
+
+// String returns basis points value formatted with a "bp" suffix, e.g. "5bp".
+func (b BasisPoints) String() string {
+	return String(b.n) + "bp"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (b BasisPoints) MarshalText() ([]byte, error) {
+	return AppendText(make([]byte, 0, appendBufSize), b.n), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Optional
+// trailing "bp" suffix is accepted.
+func (b *BasisPoints) UnmarshalText(text []byte) error {
+	n, err := FromString(string(bytes.TrimSuffix(text, []byte("bp"))))
+	if err != nil {
+		return err
+	}
+	if err := checkBounds(n); err != nil {
+		return err
+	}
+	b.n = n
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (b BasisPoints) MarshalJSON() ([]byte, error) {
+	return AppendJSON(make([]byte, 0, appendBufSize), b.n), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (b *BasisPoints) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(&b.n, data)
+}
@@ -0,0 +1,87 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPercentConversions(t *testing.T) {
+	p := NewPercent(newDecimal.New(5, 0))
+	assert.Equal(t, newDecimal.New(5, 0), p.Number())
+	assert.Equal(t, newDecimal.New(5, -2), p.Factor())
+	assert.Equal(t, newDecimal.New(5, 2), p.BasisPoints().Number())
+	assert.Equal(t, "5%", p.String())
+
+	p = PercentFromFactor(newDecimal.New(125, -4))
+	assert.True(t, newDecimal.New(125, -2).Equal(p.Number()))
+	assert.Equal(t, newDecimal.New(125, -4), p.Factor())
+
+	bp := NewBasisPoints(newDecimal.New(25, 0))
+	assert.Equal(t, newDecimal.New(25, -4), bp.Factor())
+	assert.Equal(t, newDecimal.New(25, -2), bp.Percent().Number())
+	assert.Equal(t, "25bp", bp.String())
+
+	bp = BasisPointsFromFactor(newDecimal.New(5, -4))
+	assert.True(t, newDecimal.New(5, 0).Equal(bp.Number()))
+}
+
+func TestPercentApplyTo(t *testing.T) {
+	tests := []struct {
+		p        Percent
+		n        Number
+		exp      int
+		rule     RoundRule
+		expected Number
+	}{
+		{NewPercent(New(5, 0)), New(1999, -2), -2, RoundMath, newDecimal.New(100, -2)},
+		{NewPercent(New(5, 0)), New(1999, -2), -2, RoundTruncate, newDecimal.New(99, -2)},
+		{NewPercent(New(125, -2)), New(100, 0), -2, RoundMath, newDecimal.New(125, -2)},
+		{NewPercent(New(-10, 0)), New(50, 0), 0, RoundMath, newDecimal.New(-5, 0)},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.p.ApplyTo(test.n, test.exp, test.rule), test.p.String())
+	}
+
+	assert.Equal(
+		t,
+		newDecimal.New(25, -2),
+		NewBasisPoints(New(25, 0)).ApplyTo(New(100, 0), -2, RoundMath),
+	)
+}
+
+func TestPercentMarshal(t *testing.T) {
+	data := struct {
+		Margin Percent     `json:"margin"`
+		Fee    BasisPoints `json:"fee"`
+	}{
+		NewPercent(New(55, -1)),
+		NewBasisPoints(New(12, 0)),
+	}
+
+	blob, err := json.Marshal(data)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"margin":5.5,"fee":12}`, string(blob))
+
+	data.Margin = Percent{}
+	data.Fee = BasisPoints{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"margin":"7.5","fee":3}`), &data))
+	assert.Equal(t, newDecimal.New(75, -1), data.Margin.Number())
+	assert.Equal(t, newDecimal.New(3, 0), data.Fee.Number())
+
+	var p Percent
+	assert.NoError(t, p.UnmarshalText([]byte("2.5%")))
+	assert.Equal(t, newDecimal.New(25, -1), p.Number())
+	assert.Error(t, p.UnmarshalText([]byte("2.5%%")))
+
+	var bp BasisPoints
+	assert.NoError(t, bp.UnmarshalText([]byte("15bp")))
+	assert.Equal(t, newDecimal.New(15, 0), bp.Number())
+
+	text, err := bp.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("15"), text)
+}
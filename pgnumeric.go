@@ -0,0 +1,207 @@
+package decimal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// PostgreSQL binary NUMERIC sign values.
+const (
+	pgNumericPos  = 0x0000
+	pgNumericNeg  = 0x4000
+	pgNumericNaN  = 0xC000
+	pgNumericPInf = 0xD000
+	pgNumericNInf = 0xF000
+
+	pgNumericMaxDscale = 0x3FFF
+)
+
+// AppendPgNumeric appends PostgreSQL binary NUMERIC representation of n, as
+// used by binary COPY and the binary protocol, to dst and returns the
+// extended buffer. Display scale is the number of fractional digits of n, so
+// non-positive exponents survive a round trip through ParsePgNumeric. It fails
+// with ErrOverflow if n is outside of the NUMERIC range.
+func AppendPgNumeric(dst []byte, n Number) ([]byte, error) {
+	exp := int64(n.Exponent())
+	dscale := int64(0)
+	if exp < 0 {
+		dscale = -exp
+	}
+	if dscale > pgNumericMaxDscale {
+		return dst, fmt.Errorf("%w: %s has too many fractional digits for NUMERIC", ErrOverflow, DebugString(n))
+	}
+
+	coef := n.Coefficient()
+	sign := uint16(pgNumericPos)
+	if coef.Sign() < 0 {
+		sign = pgNumericNeg
+		coef.Neg(coef)
+	}
+	if coef.Sign() == 0 {
+		return appendPgHeader(dst, 0, 0, pgNumericPos, uint16(dscale)), nil
+	}
+
+	// align the exponent to base 10000 digit boundary
+	e4 := exp / 4
+	if exp%4 < 0 {
+		e4--
+	}
+	if shift := exp - 4*e4; shift > 0 {
+		coef.Mul(coef, pow10(shift))
+	}
+	digits := pgDigits(coef)
+
+	// drop trailing zero digits, they are implied by the weight
+	trailing := 0
+	for trailing < len(digits) && digits[trailing] == 0 {
+		trailing++
+	}
+	digits = digits[trailing:]
+	weight := e4 + int64(trailing) + int64(len(digits)) - 1
+	if weight > math.MaxInt16 || weight < math.MinInt16 || len(digits) > math.MaxInt16 {
+		return dst, fmt.Errorf("%w: %s does not fit NUMERIC", ErrOverflow, DebugString(n))
+	}
+
+	dst = appendPgHeader(dst, len(digits), int16(weight), sign, uint16(dscale))
+	for i := len(digits) - 1; i >= 0; i-- {
+		dst = appendUint16(dst, digits[i])
+	}
+	return dst, nil
+}
+
+// ParsePgNumeric parses PostgreSQL binary NUMERIC representation. Returned
+// number has exponent -dscale, e.g. NUMERIC(10,2) values always have two
+// fractional digits. It fails with ErrNotFinite for NaN and infinity values
+// and ErrParse for malformed input. The byte slice is not retained.
+func ParsePgNumeric(src []byte) (Number, error) {
+	if len(src) < 8 {
+		return Number{}, fmt.Errorf("%w: NUMERIC value is %d bytes long", ErrParse, len(src))
+	}
+	ndigits := int(binary.BigEndian.Uint16(src[0:]))
+	weight := int64(int16(binary.BigEndian.Uint16(src[2:])))
+	sign := binary.BigEndian.Uint16(src[4:])
+	dscale := int64(binary.BigEndian.Uint16(src[6:]))
+	src = src[8:]
+
+	switch sign {
+	case pgNumericPos, pgNumericNeg:
+	case pgNumericNaN, pgNumericPInf, pgNumericNInf:
+		return Number{}, fmt.Errorf("%w: NUMERIC special value %#x", ErrNotFinite, sign)
+	default:
+		return Number{}, fmt.Errorf("%w: NUMERIC sign %#x", ErrParse, sign)
+	}
+	if len(src) != 2*ndigits || dscale > pgNumericMaxDscale {
+		return Number{}, fmt.Errorf("%w: malformed NUMERIC value", ErrParse)
+	}
+
+	coef := new(big.Int)
+	var acc uint64
+	accDigits := 0
+	base := big.NewInt(10000)
+	for i := 0; i < ndigits; i++ {
+		d := binary.BigEndian.Uint16(src[2*i:])
+		if d >= 10000 {
+			return Number{}, fmt.Errorf("%w: NUMERIC digit %d", ErrParse, d)
+		}
+		// up to 4 base 10000 digits are accumulated in uint64
+		acc = acc*10000 + uint64(d)
+		if accDigits++; accDigits == 4 || i == ndigits-1 {
+			coef.Mul(coef, new(big.Int).Exp(base, big.NewInt(int64(accDigits)), nil))
+			coef.Add(coef, new(big.Int).SetUint64(acc))
+			acc, accDigits = 0, 0
+		}
+	}
+	if sign == pgNumericNeg {
+		coef.Neg(coef)
+	}
+
+	exp := 4 * (weight - int64(ndigits) + 1)
+	if ndigits == 0 {
+		exp = 0
+	}
+	n := newDecimal.NewFromBigInt(coef, int32(exp))
+	scaled := Rescale(n, int32(-dscale))
+	if !scaled.Equal(n) {
+		return Number{}, fmt.Errorf("%w: NUMERIC digits beyond display scale", ErrParse)
+	}
+	return scaled, nil
+}
+
+// EncodePgNumericColumn encodes a column of numbers into PostgreSQL binary
+// NUMERIC values, e.g. for binary COPY. All values share a single buffer.
+func EncodePgNumericColumn(ns []Number) ([][]byte, error) {
+	fields := make([][]byte, len(ns))
+	buf := make([]byte, 0, 16*len(ns))
+	offsets := make([]int, len(ns)+1)
+	for i, n := range ns {
+		var err error
+		if buf, err = AppendPgNumeric(buf, n); err != nil {
+			return nil, fmt.Errorf("row %d: %w", i, err)
+		}
+		offsets[i+1] = len(buf)
+	}
+	for i := range ns {
+		fields[i] = buf[offsets[i]:offsets[i+1]:offsets[i+1]]
+	}
+	return fields, nil
+}
+
+// DecodePgNumericColumn decodes a column of PostgreSQL binary NUMERIC
+// values. Nil values are NULL and fail with ErrNull.
+func DecodePgNumericColumn(fields [][]byte) ([]Number, error) {
+	ns := make([]Number, len(fields))
+	for i, f := range fields {
+		if f == nil {
+			return nil, fmt.Errorf("%w: row %d", ErrNull, i)
+		}
+		n, err := ParsePgNumeric(f)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", i, err)
+		}
+		ns[i] = n
+	}
+	return ns, nil
+}
+
+func appendPgHeader(dst []byte, ndigits int, weight int16, sign, dscale uint16) []byte {
+	dst = appendUint16(dst, uint16(ndigits))
+	dst = appendUint16(dst, uint16(weight))
+	dst = appendUint16(dst, sign)
+	return appendUint16(dst, dscale)
+}
+
+func appendUint16(dst []byte, v uint16) []byte {
+	return append(dst, byte(v>>8), byte(v))
+}
+
+// pgDigits returns base 10000 digits of a positive coefficient, the least
+// significant first. The coefficient is modified.
+func pgDigits(coef *big.Int) []uint16 {
+	var digits []uint16
+	if coef.IsUint64() {
+		for v := coef.Uint64(); v > 0; v /= 10000 {
+			digits = append(digits, uint16(v%10000))
+		}
+		return digits
+	}
+
+	// split off 16 decimal digits at a time
+	chunk := pow10(16)
+	rem := new(big.Int)
+	for coef.Sign() > 0 {
+		coef.QuoRem(coef, chunk, rem)
+		v := rem.Uint64()
+		for j := 0; j < 4; j++ {
+			digits = append(digits, uint16(v%10000))
+			v /= 10000
+		}
+	}
+	for len(digits) > 0 && digits[len(digits)-1] == 0 {
+		digits = digits[:len(digits)-1]
+	}
+	return digits
+}
@@ -0,0 +1,95 @@
+package decimal
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPgNumeric(t *testing.T) {
+	tests := []struct {
+		n       string
+		encoded string
+	}{
+		{"0", "0000 0000 0000 0000"},
+		{"0.00", "0000 0000 0000 0002"},
+		{"1", "0001 0000 0000 0000 0001"},
+		{"-1", "0001 0000 4000 0000 0001"},
+		{"12345.678", "0003 0001 0000 0003 0001 0929 1a7c"},
+		{"10000", "0001 0001 0000 0000 0001"},
+		{"0.0001", "0001 ffff 0000 0004 0001"},
+		{"0.00001", "0001 fffe 0000 0005 03e8"},
+		{"-1.50", "0002 0000 4000 0002 0001 1388"},
+		{"123456789012345678901234567890.123456789", "000b 0007 0000 0009 000c 0d80 1ed2 04d2 162e 2334 0d80 1ed2 04d2 162e 2328"},
+	}
+
+	for _, test := range tests {
+		n := newDecimal.RequireFromString(test.n)
+		encoded, err := AppendPgNumeric(nil, n)
+		assert.NoError(t, err, test.n)
+		assert.Equal(t, strings.ReplaceAll(test.encoded, " ", ""), hex.EncodeToString(encoded), test.n)
+
+		decoded, err := ParsePgNumeric(encoded)
+		assert.NoError(t, err, test.n)
+		assert.Equal(t, test.n, decoded.StringFixed(-decoded.Exponent()), test.n)
+	}
+}
+
+func TestPgNumericPositiveExponent(t *testing.T) {
+	encoded, err := AppendPgNumeric(nil, New(15, 5))
+	assert.NoError(t, err)
+	decoded, err := ParsePgNumeric(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, New(1500000, 0), decoded)
+}
+
+func TestPgNumericErrors(t *testing.T) {
+	_, err := AppendPgNumeric(nil, New(1, -20000))
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = AppendPgNumeric(nil, New(1, 200000))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	tests := []struct {
+		encoded string
+		err     error
+	}{
+		{"0000 0000 c000 0000", ErrNotFinite},
+		{"0000 0000 d000 0000", ErrNotFinite},
+		{"0000 0000 f000 0000", ErrNotFinite},
+		{"0000 0000 1234 0000", ErrParse},
+		{"0000 0000 0000", ErrParse},
+		{"0001 0000 0000 0000", ErrParse},
+		{"0001 0000 0000 0000 2710", ErrParse},
+		{"0001 ffff 0000 0000 0001", ErrParse},
+	}
+	for _, test := range tests {
+		src, _ := hex.DecodeString(strings.ReplaceAll(test.encoded, " ", ""))
+		_, err := ParsePgNumeric(src)
+		assert.ErrorIs(t, err, test.err, test.encoded)
+	}
+}
+
+func TestPgNumericColumn(t *testing.T) {
+	column := []Number{New(125, -2), New(-3, 0), Zero(), New(1, -30)}
+	fields, err := EncodePgNumericColumn(column)
+	assert.NoError(t, err)
+	assert.Len(t, fields, len(column))
+
+	decoded, err := DecodePgNumericColumn(fields)
+	assert.NoError(t, err)
+	assert.Equal(t, column, decoded)
+
+	// fields do not overlap
+	fields[0] = append(fields[0], 0xff)
+	decoded, err = DecodePgNumericColumn(fields[1:])
+	assert.NoError(t, err)
+	assert.Equal(t, column[1:], decoded)
+
+	_, err = EncodePgNumericColumn([]Number{One, New(1, -20000)})
+	assert.EqualError(t, err, "row 1: decimal: overflow: dec(coef=1, exp=-20000) has too many fractional digits for NUMERIC")
+	_, err = DecodePgNumericColumn([][]byte{fields[1], nil})
+	assert.ErrorIs(t, err, ErrNull)
+}
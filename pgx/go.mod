@@ -0,0 +1,21 @@
+module github.com/advbet/decimal/v2/pgx
+
+go 1.25.0
+
+replace github.com/advbet/decimal/v2 => ../
+
+require (
+	github.com/advbet/decimal/v2 v2.0.0-00010101000000-000000000000
+	github.com/jackc/pgx/v5 v5.11.0
+	github.com/shopspring/decimal v1.3.1
+	github.com/stretchr/testify v1.11.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/jackc/pgpassfile v1.0.0 // indirect
+	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/text v0.29.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
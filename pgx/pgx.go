@@ -0,0 +1,95 @@
+// Package pgx converts decimal numbers to and from github.com/jackc/pgx/v5
+// NUMERIC values, so bulk inserts with CopyFrom send numbers in binary
+// format instead of formatting each of them as text.
+package pgx
+
+import (
+	"fmt"
+
+	"github.com/advbet/decimal/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// Numeric converts n to pgx NUMERIC value.
+func Numeric(n decimal.Number) pgtype.Numeric {
+	return pgtype.Numeric{Int: n.Coefficient(), Exp: n.Exponent(), Valid: true}
+}
+
+// NullNumeric converts n to pgx NUMERIC value, invalid n is converted to
+// NULL.
+func NullNumeric(n decimal.NullNumber) pgtype.Numeric {
+	if !n.Valid {
+		return pgtype.Numeric{}
+	}
+	return Numeric(n.Decimal)
+}
+
+// FromNumeric converts pgx NUMERIC value to a decimal number. NULL is
+// converted to invalid NullNumber, NaN and infinities fail with
+// decimal.ErrNotFinite.
+func FromNumeric(v pgtype.Numeric) (decimal.NullNumber, error) {
+	if !v.Valid {
+		return decimal.NullNumber{}, nil
+	}
+	if v.NaN || v.InfinityModifier != pgtype.Finite {
+		return decimal.NullNumber{}, fmt.Errorf("%w: NUMERIC special value", decimal.ErrNotFinite)
+	}
+	if v.Int == nil {
+		return decimal.NullNumber{Decimal: decimal.New(0, int(v.Exp)), Valid: true}, nil
+	}
+	return decimal.NullNumber{Decimal: newDecimal.NewFromBigInt(v.Int, v.Exp), Valid: true}, nil
+}
+
+// EncodeColumn converts a column of numbers to values accepted by
+// CopyFromColumns and pgx.CopyFromRows.
+func EncodeColumn(ns []decimal.Number) []any {
+	values := make([]pgtype.Numeric, len(ns))
+	column := make([]any, len(ns))
+	for i, n := range ns {
+		values[i] = Numeric(n)
+		column[i] = &values[i]
+	}
+	return column
+}
+
+// DecodeColumn converts a column of pgx NUMERIC values to decimal numbers.
+// NULL values fail with decimal.ErrNull.
+func DecodeColumn(vs []pgtype.Numeric) ([]decimal.Number, error) {
+	ns := make([]decimal.Number, len(vs))
+	for i, v := range vs {
+		n, err := FromNumeric(v)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", i, err)
+		}
+		if !n.Valid {
+			return nil, fmt.Errorf("%w: row %d", decimal.ErrNull, i)
+		}
+		ns[i] = n.Decimal
+	}
+	return ns, nil
+}
+
+// CopyFromColumns returns pgx.CopyFromSource producing rows from columns of
+// values, the i-th row holds the i-th value of each column. It panics if the
+// columns differ in length.
+func CopyFromColumns(columns ...[]any) pgx.CopyFromSource {
+	rows := 0
+	if len(columns) > 0 {
+		rows = len(columns[0])
+	}
+	for _, c := range columns {
+		if len(c) != rows {
+			panic(fmt.Sprintf("decimal: column length %d differs from %d", len(c), rows))
+		}
+	}
+
+	row := make([]any, len(columns))
+	return pgx.CopyFromSlice(rows, func(i int) ([]any, error) {
+		for j, c := range columns {
+			row[j] = c[i]
+		}
+		return row, nil
+	})
+}
@@ -0,0 +1,78 @@
+package pgx
+
+import (
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	"github.com/jackc/pgx/v5/pgtype"
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumericBinary(t *testing.T) {
+	m := pgtype.NewMap()
+	for _, str := range []string{"0", "0.00", "-1.50", "12345.678", "0.00001", "123456789012345678901234567890.123456789"} {
+		n := newDecimal.RequireFromString(str)
+
+		// pgx binary encoding is readable by decimal.ParsePgNumeric
+		encoded, err := m.Encode(pgtype.NumericOID, pgtype.BinaryFormatCode, Numeric(n), nil)
+		assert.NoError(t, err, str)
+		parsed, err := decimal.ParsePgNumeric(encoded)
+		assert.NoError(t, err, str)
+		assert.Equal(t, str, parsed.StringFixed(-parsed.Exponent()), str)
+
+		// and vice versa, pgx does not keep exponent of zero
+		encoded, err = decimal.AppendPgNumeric(nil, n)
+		assert.NoError(t, err, str)
+		var v pgtype.Numeric
+		assert.NoError(t, m.Scan(pgtype.NumericOID, pgtype.BinaryFormatCode, encoded, &v), str)
+		decoded, err := FromNumeric(v)
+		assert.NoError(t, err, str)
+		assert.True(t, decoded.Valid, str)
+		assert.True(t, n.Equal(decoded.Decimal), str)
+	}
+}
+
+func TestFromNumeric(t *testing.T) {
+	n, err := FromNumeric(pgtype.Numeric{})
+	assert.NoError(t, err)
+	assert.False(t, n.Valid)
+
+	_, err = FromNumeric(pgtype.Numeric{NaN: true, Valid: true})
+	assert.ErrorIs(t, err, decimal.ErrNotFinite)
+	_, err = FromNumeric(pgtype.Numeric{InfinityModifier: pgtype.NegativeInfinity, Valid: true})
+	assert.ErrorIs(t, err, decimal.ErrNotFinite)
+
+	assert.Equal(t, pgtype.Numeric{}, NullNumeric(decimal.NullNumber{}))
+	n, err = FromNumeric(NullNumeric(decimal.NullNumber{Decimal: decimal.New(15, -1), Valid: true}))
+	assert.NoError(t, err)
+	assert.Equal(t, decimal.NullNumber{Decimal: decimal.New(15, -1), Valid: true}, n)
+}
+
+func TestColumns(t *testing.T) {
+	stakes := []decimal.Number{decimal.New(1000, -2), decimal.New(250, -2)}
+	payouts := []decimal.Number{decimal.New(1850, -2), decimal.Zero()}
+
+	src := CopyFromColumns([]any{int64(1), int64(2)}, EncodeColumn(stakes), EncodeColumn(payouts))
+	var got [][]pgtype.Numeric
+	for src.Next() {
+		row, err := src.Values()
+		assert.NoError(t, err)
+		assert.Len(t, row, 3)
+		got = append(got, []pgtype.Numeric{*row[1].(*pgtype.Numeric), *row[2].(*pgtype.Numeric)})
+	}
+	assert.NoError(t, src.Err())
+	assert.Len(t, got, 2)
+
+	decoded, err := DecodeColumn([]pgtype.Numeric{got[0][0], got[1][0]})
+	assert.NoError(t, err)
+	assert.Equal(t, stakes, decoded)
+	decoded, err = DecodeColumn([]pgtype.Numeric{got[0][1], got[1][1]})
+	assert.NoError(t, err)
+	assert.Equal(t, payouts, decoded)
+
+	_, err = DecodeColumn([]pgtype.Numeric{Numeric(decimal.One), {}})
+	assert.ErrorIs(t, err, decimal.ErrNull)
+
+	assert.Panics(t, func() { CopyFromColumns([]any{1}, []any{}) })
+}
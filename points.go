@@ -0,0 +1,19 @@
+package decimal
+
+// AccruePoints converts amount to whole loyalty points at the given rate,
+// points per unit of amount, rounded using the given rounding rule. Residual
+// is the exact part of amount * rate not awarded, it is negative when points
+// were rounded up. Carrying residuals to the next accrual with
+// AccruePointsCarry keeps the total awarded within one rounding step of the
+// exact total instead of drifting with every transaction.
+func AccruePoints(amount Number, rate Number, rounding RoundRule) (points Number, residual Number) {
+	return AccruePointsCarry(amount, rate, Zero(), rounding)
+}
+
+// AccruePointsCarry is AccruePoints adding carry, the residual of the
+// previous accrual, to amount * rate before rounding.
+func AccruePointsCarry(amount, rate, carry Number, rounding RoundRule) (points Number, residual Number) {
+	exact := amount.Mul(rate).Add(carry)
+	points = Round(exact, 0, rounding)
+	return points, exact.Sub(points)
+}
@@ -0,0 +1,54 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccruePoints(t *testing.T) {
+	tests := []struct {
+		amount, rate string
+		rule         RoundRule
+		points       string
+		residual     string
+	}{
+		{"12.34", "1", RoundTruncate, "12", "0.34"},
+		{"12.5", "1", RoundBankers, "12", "0.5"},
+		{"13.5", "1", RoundBankers, "14", "-0.5"},
+		{"19.99", "0.1", RoundMath, "2", "-0.001"},
+		{"-7.25", "2", RoundBankers, "-14", "-0.5"},
+		{"0", "5", RoundBankers, "0", "0"},
+	}
+
+	for _, test := range tests {
+		n := numbers(test.amount, test.rate)
+		points, residual := AccruePoints(n[0], n[1], test.rule)
+		assert.Equal(t, test.points, points.String(), "%s * %s", test.amount, test.rate)
+		assert.Equal(t, test.residual, residual.String(), "%s * %s", test.amount, test.rate)
+		assert.Equal(t, int32(0), points.Exponent())
+	}
+}
+
+func TestAccruePointsCarry(t *testing.T) {
+	rate := New(1, -1)
+	amounts := numbers("9.99", "4.50", "14.99", "2.25", "7.80", "19.99", "0.99")
+
+	// per-transaction truncation under-awards
+	truncated := Zero()
+	for _, a := range amounts {
+		points, _ := AccruePoints(a, rate, RoundTruncate)
+		truncated = truncated.Add(points)
+	}
+	assert.Equal(t, "2", truncated.String())
+
+	total, carry := Zero(), Zero()
+	for _, a := range amounts {
+		var points Number
+		points, carry = AccruePointsCarry(a, rate, carry, RoundTruncate)
+		total = total.Add(points)
+	}
+	// exact total is 6.051 points
+	assert.Equal(t, "6", total.String())
+	assert.Equal(t, "0.051", carry.String())
+}
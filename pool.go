@@ -0,0 +1,70 @@
+package decimal
+
+import (
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// Pool is a reusable parsing arena for bulk ingestion of decimal numbers.
+// Parsed numbers are stored in a single slab that is reused after Release,
+// and numbers having more than 19 digits are assembled in a shared scratch
+// space instead of temporary big.Int values.
+//
+// Coefficients of numbers that do not fit int64 are still copied into their
+// own storage by the underlying decimal library, so numbers returned by the
+// pool stay valid after Release. Only slices returned by ParseBatch are
+// invalidated. Pool is not safe for concurrent use.
+type Pool struct {
+	slab    []Number
+	scratch parseScratch
+}
+
+// NewPool creates a pool with a slab preallocated for the given number of
+// values.
+func NewPool(capacity int) *Pool {
+	return &Pool{slab: make([]Number, 0, capacity)}
+}
+
+// Parse parses a single decimal number using the pool scratch space, see
+// FromBytes for accepted formats.
+func (p *Pool) Parse(b []byte) (Number, error) {
+	if n, ok := parsePlain(b, &p.scratch); ok {
+		return n, nil
+	}
+
+	str := string(b)
+	n, err := newDecimal.NewFromString(str)
+	if err != nil {
+		return Number{}, &ParseError{Input: str, Err: err}
+	}
+	return n, nil
+}
+
+// ParseBatch parses all values into the pool slab. Returned slice is valid
+// until the next Release call. On error values parsed so far are discarded
+// and the returned error is a *ParseError.
+func (p *Pool) ParseBatch(values [][]byte) ([]Number, error) {
+	start := len(p.slab)
+	for _, b := range values {
+		n, err := p.Parse(b)
+		if err != nil {
+			p.slab = p.slab[:start]
+			return nil, err
+		}
+		p.slab = append(p.slab, n)
+	}
+	return p.slab[start:len(p.slab):len(p.slab)], nil
+}
+
+// Len returns number of values held in the pool slab.
+func (p *Pool) Len() int {
+	return len(p.slab)
+}
+
+// Release discards all values held in the pool slab, retaining allocated
+// storage for the next batch.
+func (p *Pool) Release() {
+	for i := range p.slab {
+		p.slab[i] = Number{}
+	}
+	p.slab = p.slab[:0]
+}
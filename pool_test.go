@@ -0,0 +1,63 @@
+package decimal
+
+import (
+	"strconv"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoolParseBatch(t *testing.T) {
+	p := NewPool(4)
+
+	first, err := p.ParseBatch([][]byte{[]byte("1.5"), []byte("-2"), []byte("12345678901234567890.123")})
+	assert.NoError(t, err)
+	assert.Equal(t, []Number{New(15, -1), New(-2, 0), newDecimal.RequireFromString("12345678901234567890.123")}, first)
+	assert.Equal(t, 3, p.Len())
+
+	second, err := p.ParseBatch([][]byte{[]byte("1e3"), []byte("98765432109876543210")})
+	assert.NoError(t, err)
+	assert.Equal(t, []Number{New(1, 3), newDecimal.RequireFromString("98765432109876543210")}, second)
+	assert.Equal(t, 5, p.Len())
+
+	// big coefficients must not share the scratch space
+	assert.Equal(t, "12345678901234567890.123", first[2].String())
+
+	_, err = p.ParseBatch([][]byte{[]byte("7"), []byte("x")})
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrParse)
+	assert.Equal(t, 5, p.Len())
+
+	n := second[1]
+	p.Release()
+	assert.Equal(t, 0, p.Len())
+	assert.Equal(t, "98765432109876543210", n.String())
+}
+
+func TestPoolParse(t *testing.T) {
+	var p Pool
+	n, err := p.Parse([]byte("-0.25"))
+	assert.NoError(t, err)
+	assert.Equal(t, New(-25, -2), n)
+
+	_, err = p.Parse([]byte("1.2.3"))
+	assert.IsType(t, &ParseError{}, err)
+}
+
+func BenchmarkPoolParseBatch(b *testing.B) {
+	values := make([][]byte, 1000)
+	for i := range values {
+		values[i] = []byte(strconv.Itoa(i) + ".25")
+	}
+	p := NewPool(len(values))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := p.ParseBatch(values); err != nil {
+			b.Fatal(err)
+		}
+		p.Release()
+	}
+}
@@ -0,0 +1,53 @@
+package decimal
+
+import (
+	"time"
+)
+
+// DayCount is enum type for specifying day count convention used to measure
+// length of a period in years.
+type DayCount int
+
+// List of supported day count conventions
+const (
+	DayCount30360     DayCount = iota // 30/360 bond basis, every month has 30 days
+	DayCountActual365                 // Actual/365 fixed, actual number of days over 365
+)
+
+// Prorate calculates amount * partial / total, e.g. part of a subscription fee
+// for the used part of the billing period. Durations are used exactly with
+// nanosecond resolution, the result is rounded once to the given exponent
+// using the given rounding rule. It panics if total is zero.
+func Prorate(amount Number, partial, total time.Duration, exp int, rule RoundRule) Number {
+	return divRound(amount.Mul(New(int64(partial), 0)), New(int64(total), 0), exp, rule)
+}
+
+// ProrateDays calculates part of an annual amount accrued over the period from
+// start to end using the given day count convention. Only calendar dates of
+// start and end are used, time of day is ignored. The result is rounded once
+// to the given exponent using the given rounding rule.
+func ProrateDays(annual Number, start, end time.Time, convention DayCount, exp int, rule RoundRule) Number {
+	days, basis := dayCount(start, end, convention)
+	return divRound(annual.Mul(New(days, 0)), New(basis, 0), exp, rule)
+}
+
+// dayCount returns number of days between dates and number of days in a year
+// according to the convention.
+func dayCount(start, end time.Time, convention DayCount) (days, basis int64) {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+
+	if convention == DayCountActual365 {
+		from := time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC)
+		return int64(to.Sub(from) / (24 * time.Hour)), 365
+	}
+
+	if d1 == 31 {
+		d1 = 30
+	}
+	if d2 == 31 && d1 == 30 {
+		d2 = 30
+	}
+	return int64(360*(y2-y1) + 30*(int(m2)-int(m1)) + (d2 - d1)), 360
+}
@@ -0,0 +1,61 @@
+package decimal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProrate(t *testing.T) {
+	tests := []struct {
+		amount   Number
+		partial  time.Duration
+		total    time.Duration
+		rule     RoundRule
+		expected string
+	}{
+		{New(30, 0), 10 * 24 * time.Hour, 30 * 24 * time.Hour, RoundBankers, "10"},
+		{New(999, -2), 7 * 24 * time.Hour, 30 * 24 * time.Hour, RoundBankers, "2.33"},
+		{New(999, -2), 7 * 24 * time.Hour, 30 * 24 * time.Hour, RoundCeil, "2.34"},
+		{New(100, 0), time.Nanosecond, 3 * time.Nanosecond, RoundTruncate, "33.33"},
+		{New(100, 0), 0, time.Hour, RoundBankers, "0"},
+	}
+
+	for _, test := range tests {
+		actual := Prorate(test.amount, test.partial, test.total, -2, test.rule)
+		assert.Equal(t, test.expected, actual.String())
+	}
+
+	assert.Panics(t, func() { Prorate(One, time.Hour, 0, -2, RoundBankers) })
+}
+
+func TestProrateDays(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		start, end time.Time
+		convention DayCount
+		expected   string
+	}{
+		{date(2023, 1, 1), date(2024, 1, 1), DayCount30360, "3600"},
+		{date(2023, 1, 1), date(2024, 1, 1), DayCountActual365, "3600"},
+		{date(2024, 1, 1), date(2025, 1, 1), DayCountActual365, "3609.86"},
+		{date(2023, 1, 31), date(2023, 3, 31), DayCount30360, "600"},
+		{date(2023, 1, 31), date(2023, 3, 31), DayCountActual365, "581.92"},
+		{date(2023, 2, 28), date(2023, 3, 31), DayCount30360, "330"},
+		{date(2023, 1, 15), date(2023, 1, 16), DayCount30360, "10"},
+		{date(2023, 1, 15), date(2023, 1, 16), DayCountActual365, "9.86"},
+	}
+
+	for _, test := range tests {
+		actual := ProrateDays(New(3600, 0), test.start, test.end, test.convention, -2, RoundBankers)
+		assert.Equal(t, test.expected, actual.String(), "%s - %s", test.start, test.end)
+	}
+
+	// time of day is ignored
+	start := time.Date(2023, 1, 1, 23, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 1, 2, 1, 0, 0, 0, time.UTC)
+	assert.Equal(t, "10", ProrateDays(New(3650, 0), start, end, DayCountActual365, -2, RoundBankers).String())
+}
@@ -0,0 +1,27 @@
+//go:build go1.23
+
+package decimal
+
+import (
+	"iter"
+)
+
+// Range returns an iterator over numbers from, from+step, from+2*step and so
+// on while they do not pass to, i.e. to is included if it is reached exactly.
+// Negative step produces a descending sequence. Decimal addition is exact, so
+// steps never drift. It panics if step is zero.
+func Range(from, to, step Number) iter.Seq[Number] {
+	if step.Sign() == 0 {
+		panic("decimal: range step must not be zero")
+	}
+	from = ensureInitialized(from)
+	dir := step.Sign()
+
+	return func(yield func(Number) bool) {
+		for n := from; Cmp(n, to)*dir <= 0; n = n.Add(step) {
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
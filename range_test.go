@@ -0,0 +1,60 @@
+//go:build go1.23
+
+package decimal
+
+import (
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		from, to, step string
+		expected       []string
+	}{
+		{"1", "2", "0.25", []string{"1", "1.25", "1.5", "1.75", "2"}},
+		{"1", "1.9", "0.25", []string{"1", "1.25", "1.5", "1.75"}},
+		{"0.1", "0.3", "0.1", []string{"0.1", "0.2", "0.3"}},
+		{"2", "1", "-0.5", []string{"2", "1.5", "1"}},
+		{"-1", "1", "1", []string{"-1", "0", "1"}},
+		{"1", "1", "1", []string{"1"}},
+		{"2", "1", "1", nil},
+		{"1", "2", "-1", nil},
+	}
+
+	for _, test := range tests {
+		var actual []string
+		for n := range Range(newDecimal.RequireFromString(test.from), newDecimal.RequireFromString(test.to), newDecimal.RequireFromString(test.step)) {
+			actual = append(actual, n.String())
+		}
+		assert.Equal(t, test.expected, actual, "%s..%s step %s", test.from, test.to, test.step)
+	}
+}
+
+func TestRangeBreak(t *testing.T) {
+	var actual []Number
+	for n := range Range(Number{}, New(100, 0), New(1, -2)) {
+		if len(actual) == 3 {
+			break
+		}
+		actual = append(actual, n)
+	}
+	assert.Equal(t, []Number{New(0, 0), New(1, -2), New(2, -2)}, actual)
+}
+
+func TestRangeNoDrift(t *testing.T) {
+	count := 0
+	var last Number
+	for n := range Range(New(101, -2), New(1000, 0), New(1, -2)) {
+		count++
+		last = n
+	}
+	assert.Equal(t, 99900, count)
+	assert.Equal(t, "1000", last.String())
+}
+
+func TestRangeZeroStep(t *testing.T) {
+	assert.Panics(t, func() { Range(One, Ten, Zero()) })
+}
@@ -0,0 +1,55 @@
+package decimal
+
+import (
+	"math/big"
+)
+
+// ApproxRat returns the fraction closest to n having denominator not greater
+// than maxDen, e.g. 1.53 is approximated by 23/15 with maxDen 15, so decimal
+// odds 1.53 are displayed as fractional odds 8/15. The exact value of n is
+// returned if its denominator fits. The approximation is found with continued
+// fractions, on a tie the convergent is preferred over the semiconvergent. It
+// panics if maxDen is not positive.
+func ApproxRat(n Number, maxDen int64) *big.Rat {
+	if maxDen < 1 {
+		panic("decimal: maximum denominator must be positive")
+	}
+	r := n.Rat()
+	limit := big.NewInt(maxDen)
+	if r.Denom().Cmp(limit) <= 0 {
+		return r
+	}
+
+	// convergents p0/q0 and p1/q1 of the continued fraction of num/den
+	num, den := new(big.Int).Set(r.Num()), new(big.Int).Set(r.Denom())
+	p0, q0, p1, q1 := big.NewInt(0), big.NewInt(1), big.NewInt(1), big.NewInt(0)
+	a, rem, tmp := new(big.Int), new(big.Int), new(big.Int)
+	for {
+		a.DivMod(num, den, rem)
+		q2 := tmp.Mul(a, q1)
+		q2.Add(q2, q0)
+		if q2.Cmp(limit) > 0 {
+			break
+		}
+		p2 := new(big.Int).Mul(a, p1)
+		p2.Add(p2, p0)
+		p0, q0, p1, q1 = p1, q1, p2, new(big.Int).Set(q2)
+		num, den = den, new(big.Int).Set(rem)
+	}
+
+	// the best semiconvergent (p0 + k*p1) / (q0 + k*q1)
+	k := new(big.Int).Sub(limit, q0)
+	k.Div(k, q1)
+	semi := new(big.Rat).SetFrac(
+		new(big.Int).Add(p0, new(big.Int).Mul(k, p1)),
+		new(big.Int).Add(q0, new(big.Int).Mul(k, q1)),
+	)
+	conv := new(big.Rat).SetFrac(p1, q1)
+
+	semiDiff := new(big.Rat).Sub(semi, r)
+	convDiff := new(big.Rat).Sub(conv, r)
+	if convDiff.Abs(convDiff).Cmp(semiDiff.Abs(semiDiff)) <= 0 {
+		return conv
+	}
+	return semi
+}
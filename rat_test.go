@@ -0,0 +1,59 @@
+package decimal
+
+import (
+	"math/big"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApproxRat(t *testing.T) {
+	tests := []struct {
+		n        Number
+		maxDen   int64
+		expected string
+	}{
+		{New(153, -2), 15, "23/15"},
+		{New(153, -2), 20, "26/17"},
+		{New(153, -2), 100, "153/100"},
+		{New(153, -2), 1000, "153/100"},
+		{New(-153, -2), 15, "-23/15"},
+		{New(5, -1), 1, "0/1"},
+		{New(15, -1), 1, "1/1"},
+		{New(25, -1), 1, "2/1"},
+		{New(3333, -4), 10, "1/3"},
+		{New(1, 0), 1, "1/1"},
+		{New(12, 3), 7, "12000/1"},
+		{Number{}, 5, "0/1"},
+		{newDecimal.RequireFromString("3.14159265358979"), 10, "22/7"},
+		{newDecimal.RequireFromString("3.14159265358979"), 1000, "355/113"},
+		{New(2, -1), 4, "1/4"},
+		{New(1, -9), 1000, "0/1"},
+	}
+
+	for _, test := range tests {
+		actual := ApproxRat(test.n, test.maxDen)
+		assert.Equal(t, test.expected, actual.String(), "%s max %d", test.n, test.maxDen)
+		assert.LessOrEqual(t, actual.Denom().Int64(), test.maxDen)
+	}
+
+	assert.Panics(t, func() { ApproxRat(One, 0) })
+}
+
+func TestApproxRatBest(t *testing.T) {
+	// no fraction with a denominator up to maxDen is closer
+	n := New(61803398875, -11)
+	r := n.Rat()
+	for maxDen := int64(1); maxDen <= 60; maxDen++ {
+		best := ApproxRat(n, maxDen)
+		diff := new(big.Rat).Sub(best, r)
+		diff.Abs(diff)
+		for den := int64(1); den <= maxDen; den++ {
+			for num := int64(0); num <= den; num++ {
+				d := new(big.Rat).Sub(big.NewRat(num, den), r)
+				assert.True(t, d.Abs(d).Cmp(diff) >= 0, "%d/%d is closer than %s", num, den, best)
+			}
+		}
+	}
+}
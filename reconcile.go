@@ -0,0 +1,113 @@
+package decimal
+
+import (
+	"math/big"
+	"sort"
+
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// Adjustment is a change of a single part needed to balance a
+// reconciliation.
+type Adjustment struct {
+	Index int    // Index of the part in the reconciled slice
+	Delta Number // Delta to add to the part, a multiple of 10^exp
+}
+
+// Reconciliation is a report of matching parts against their expected total.
+type Reconciliation struct {
+	Sum  Number // Sum is the exact sum of parts
+	Diff Number // Diff is the expected total minus Sum
+	// Adjustments lists parts that need to be changed by whole units of
+	// 10^exp to balance the total, sorted by index. Units are given to the
+	// parts of the largest absolute value first, ties are broken by lower
+	// index. Units exceeding number of parts are spread evenly.
+	Adjustments []Adjustment
+	// Residue is the part of Diff smaller than half of 10^exp that can not
+	// be balanced with whole units.
+	Residue Number
+}
+
+// Balanced reports if parts balance the expected total at the exponent of
+// the report, i.e. Diff rounds to zero and only the Residue is left. It agrees
+// with the ok result of Reconcile, check Diff to match the total exactly.
+func (r Reconciliation) Balanced() bool {
+	return r.Diff.Sub(r.Residue).Sign() == 0
+}
+
+// Reconcile compares exact sum of parts with expected total. It returns the
+// exact difference expectedTotal - sum(parts) and reports if the parts
+// balance the total at the given exponent, i.e. the difference rounds to zero.
+func Reconcile(expectedTotal Number, parts []Number, exp int) (diff Number, ok bool) {
+	diff = expectedTotal.Sub(sumExact(parts))
+	return diff, Round(diff, exp, RoundMath).Sign() == 0
+}
+
+// ReconcileReport compares exact sum of parts with expected total and lists
+// the adjustments of parts by whole units of 10^exp that balance it. The
+// difference is rounded half away from zero to whole units. Adjustments are
+// deterministic, equal input always produces an equal report.
+func ReconcileReport(expectedTotal Number, parts []Number, exp int) Reconciliation {
+	sum := sumExact(parts)
+	diff := expectedTotal.Sub(sum)
+	rounded := Round(diff, exp, RoundMath)
+	report := Reconciliation{
+		Sum:     sum,
+		Diff:    diff,
+		Residue: diff.Sub(rounded),
+	}
+	if rounded.Sign() == 0 || len(parts) == 0 {
+		return report
+	}
+
+	order := make([]int, len(parts))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return parts[order[i]].Abs().Cmp(parts[order[j]].Abs()) > 0
+	})
+
+	// units = rounded / 10^exp, every part gets units / n, first units % n
+	// parts in order get one more
+	units := Rescale(rounded, int32(exp)).Coefficient()
+	each, extra := new(big.Int).QuoRem(units, big.NewInt(int64(len(parts))), new(big.Int))
+	one := big.NewInt(int64(units.Sign()))
+	k := int(new(big.Int).Abs(extra).Int64())
+
+	deltas := make([]*big.Int, len(parts))
+	for rank, i := range order {
+		d := new(big.Int).Set(each)
+		if rank < k {
+			d.Add(d, one)
+		}
+		deltas[i] = d
+	}
+	for i, d := range deltas {
+		if d.Sign() != 0 {
+			report.Adjustments = append(report.Adjustments, Adjustment{
+				Index: i,
+				Delta: newDecimal.NewFromBigInt(d, int32(exp)),
+			})
+		}
+	}
+	return report
+}
+
+// DiffBalances calculates exact per-key deltas after - before between two
+// balance snapshots. Keys missing from a snapshot have zero balance. Keys
+// with numerically equal balances are omitted, e.g. 1.5 and 1.50 are equal.
+func DiffBalances(before, after map[string]Number) map[string]Number {
+	res := make(map[string]Number)
+	for k, a := range after {
+		if delta := a.Sub(before[k]); delta.Sign() != 0 {
+			res[k] = delta
+		}
+	}
+	for k, b := range before {
+		if _, ok := after[k]; !ok && b.Sign() != 0 {
+			res[k] = b.Neg()
+		}
+	}
+	return res
+}
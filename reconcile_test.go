@@ -0,0 +1,173 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		total Number
+		parts []Number
+		exp   int
+		diff  Number
+		ok    bool
+	}{
+		{New(100, 0), []Number{New(3333, -2), New(3333, -2), New(3334, -2)}, -2, New(0, -2), true},
+		{New(100, 0), []Number{New(3333, -2), New(3333, -2), New(3333, -2)}, -2, New(1, -2), false},
+		{New(100, 0), []Number{New(33333, -3), New(33333, -3), New(33333, -3)}, -2, New(1, -3), true},
+		{New(100, 0), []Number{New(33333, -3), New(33333, -3), New(33332, -3)}, -2, New(2, -3), true},
+		{New(100, 0), []Number{New(3333, -2), New(3333, -2), New(33335, -3)}, -2, New(5, -3), false},
+		{New(5, 0), nil, -2, New(5, 0), false},
+		{Number{}, nil, -2, New(0, 0), true},
+	}
+
+	for _, test := range tests {
+		diff, ok := Reconcile(test.total, test.parts, test.exp)
+		assert.True(t, test.diff.Equal(diff), "%s != %s", test.diff, diff)
+		assert.Equal(t, test.ok, ok, test.parts)
+	}
+}
+
+func TestReconcileReport(t *testing.T) {
+	tests := []struct {
+		name        string
+		total       Number
+		parts       []Number
+		exp         int
+		adjustments []Adjustment
+		residue     Number
+	}{
+		{
+			name:  "balanced",
+			total: New(100, 0),
+			parts: []Number{New(3333, -2), New(3333, -2), New(3334, -2)},
+			exp:   -2,
+		},
+		{
+			name:        "short by one unit, largest part adjusted",
+			total:       New(100, 0),
+			parts:       []Number{New(3333, -2), New(3334, -2), New(3332, -2)},
+			exp:         -2,
+			adjustments: []Adjustment{{1, New(1, -2)}},
+		},
+		{
+			name:        "ties broken by lower index",
+			total:       New(100, 0),
+			parts:       []Number{New(3333, -2), New(3333, -2), New(3332, -2)},
+			exp:         -2,
+			adjustments: []Adjustment{{0, New(1, -2)}, {1, New(1, -2)}},
+		},
+		{
+			name:        "over by one unit",
+			total:       New(1, 0),
+			parts:       []Number{New(33, -2), New(34, -2), New(1, -2), New(33, -2)},
+			exp:         -2,
+			adjustments: []Adjustment{{1, New(-1, -2)}},
+		},
+		{
+			name:        "negative parts ranked by absolute value",
+			total:       New(-10, -1),
+			parts:       []Number{New(2, -1), New(-13, -1)},
+			exp:         -1,
+			adjustments: []Adjustment{{1, New(1, -1)}},
+		},
+		{
+			name:        "units spread over parts",
+			total:       New(10, 0),
+			parts:       []Number{New(1, 0), New(2, 0), New(3, 0)},
+			exp:         0,
+			adjustments: []Adjustment{{0, New(1, 0)}, {1, New(1, 0)}, {2, New(2, 0)}},
+		},
+		{
+			name:        "residue",
+			total:       New(1, 0),
+			parts:       []Number{New(333, -3), New(333, -3), New(333, -3)},
+			exp:         -2,
+			adjustments: nil,
+			residue:     New(1, -3),
+		},
+		{
+			name:        "rounded units and residue",
+			total:       New(1, 0),
+			parts:       []Number{New(333, -3), New(333, -3), New(328, -3)},
+			exp:         -2,
+			adjustments: []Adjustment{{0, New(1, -2)}},
+			residue:     New(-4, -3),
+		},
+	}
+
+	for _, test := range tests {
+		report := ReconcileReport(test.total, test.parts, test.exp)
+		assert.True(t, report.Sum.Add(report.Diff).Equal(test.total), test.name)
+		assert.Equal(t, len(test.adjustments), len(report.Adjustments), test.name)
+		for i, a := range test.adjustments {
+			if i < len(report.Adjustments) {
+				assert.Equal(t, a.Index, report.Adjustments[i].Index, test.name)
+				assert.True(t, a.Delta.Equal(report.Adjustments[i].Delta), test.name)
+			}
+		}
+		assert.True(t, test.residue.Equal(report.Residue), "%s: %s", test.name, report.Residue)
+
+		// applying adjustments leaves only the residue
+		parts := append([]Number(nil), test.parts...)
+		for _, a := range report.Adjustments {
+			parts[a.Index] = parts[a.Index].Add(a.Delta)
+		}
+		diff, _ := Reconcile(test.total, parts, test.exp)
+		assert.True(t, diff.Equal(test.residue), test.name)
+	}
+
+	assert.True(t, ReconcileReport(New(1, 0), []Number{New(1, 0)}, -2).Balanced())
+	assert.False(t, ReconcileReport(New(1, 0), []Number{New(1, -2)}, -2).Balanced())
+}
+
+func TestReconcileBalancedAgrees(t *testing.T) {
+	tests := []struct {
+		parts    []Number
+		balanced bool
+	}{
+		{[]Number{New(5, -1), New(5, -1)}, true},
+		{[]Number{New(5, -1), New(499, -3)}, true}, // 0.999 rounds to 1.00
+		{[]Number{New(5, -1), New(4951, -4)}, true},
+		{[]Number{New(5, -1), New(495, -3)}, false}, // 0.005 diff rounds away from zero
+		{[]Number{New(5, -1)}, false},
+		{nil, false},
+	}
+
+	for _, test := range tests {
+		diff, ok := Reconcile(New(100, -2), test.parts, -2)
+		report := ReconcileReport(New(100, -2), test.parts, -2)
+		assert.Equal(t, test.balanced, ok, test.parts)
+		assert.Equal(t, test.balanced, report.Balanced(), test.parts)
+		assert.True(t, diff.Equal(report.Diff), test.parts)
+	}
+	// exactly balanced parts are checked with Diff
+	assert.False(t, ReconcileReport(New(100, -2), []Number{New(999, -3)}, -2).Diff.IsZero())
+}
+
+func TestDiffBalances(t *testing.T) {
+	before := map[string]Number{
+		"alice": New(1000, -2),
+		"bob":   New(15, -1),
+		"carol": New(5, 0),
+		"dave":  Zero(),
+	}
+	after := map[string]Number{
+		"alice": New(1250, -2),
+		"bob":   New(150, -2),
+		"dave":  New(0, -2),
+		"erin":  New(3, -1),
+		"frank": Number{},
+	}
+
+	actual := DiffBalances(before, after)
+	assert.Len(t, actual, 3)
+	assert.Equal(t, "2.5", actual["alice"].String())
+	assert.Equal(t, "-5", actual["carol"].String())
+	assert.Equal(t, "0.3", actual["erin"].String())
+
+	assert.Empty(t, DiffBalances(nil, nil))
+	assert.Empty(t, DiffBalances(before, before))
+}
@@ -0,0 +1,51 @@
+package decimal
+
+// RunningTotal keeps an exact running balance of added amounts and allows
+// recording checkpoints, e.g. balance at the end of every statement page. No
+// rounding is ever performed, so per-row balances never drift from the grand
+// total. Zero value is a running total starting at zero.
+type RunningTotal struct {
+	total       Number
+	checkpoints []Number
+}
+
+// NewRunningTotal creates a running total starting at the opening balance.
+func NewRunningTotal(opening Number) *RunningTotal {
+	return &RunningTotal{total: opening}
+}
+
+// Add adds n to the running total and returns the new total.
+func (r *RunningTotal) Add(n Number) Number {
+	r.total = ensureInitialized(r.total).Add(n)
+	return r.total
+}
+
+// Total returns the current total.
+func (r *RunningTotal) Total() Number {
+	return ensureInitialized(r.total)
+}
+
+// Checkpoint records the current total and returns index of the checkpoint.
+func (r *RunningTotal) Checkpoint() int {
+	r.checkpoints = append(r.checkpoints, r.Total())
+	return len(r.checkpoints) - 1
+}
+
+// At returns total recorded at the i-th checkpoint. It panics if i is out of
+// range.
+func (r *RunningTotal) At(i int) Number {
+	return r.checkpoints[i]
+}
+
+// Since returns sum of amounts added after the i-th checkpoint. It panics if
+// i is out of range.
+func (r *RunningTotal) Since(i int) Number {
+	return r.Total().Sub(r.checkpoints[i])
+}
+
+// Restore resets the total to the value recorded at the i-th checkpoint and
+// discards all later checkpoints. It panics if i is out of range.
+func (r *RunningTotal) Restore(i int) {
+	r.total = r.checkpoints[i]
+	r.checkpoints = r.checkpoints[:i+1]
+}
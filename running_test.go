@@ -0,0 +1,38 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunningTotal(t *testing.T) {
+	var r RunningTotal
+	assert.Equal(t, Zero(), r.Total())
+	assert.Equal(t, "10.5", r.Add(New(105, -1)).String())
+	assert.Equal(t, "8", r.Add(New(-25, -1)).String())
+
+	first := r.Checkpoint()
+	assert.Equal(t, 0, first)
+	r.Add(New(1, -2))
+	r.Add(New(2, -2))
+	second := r.Checkpoint()
+	r.Add(New(-5, 0))
+
+	assert.Equal(t, "8", r.At(first).String())
+	assert.Equal(t, "8.03", r.At(second).String())
+	assert.Equal(t, "-4.97", r.Since(first).String())
+	assert.Equal(t, "-5", r.Since(second).String())
+	assert.Equal(t, "3.03", r.Total().String())
+
+	r.Restore(first)
+	assert.Equal(t, "8", r.Total().String())
+	assert.Equal(t, 1, r.Checkpoint())
+	assert.Panics(t, func() { r.At(2) })
+}
+
+func TestNewRunningTotal(t *testing.T) {
+	r := NewRunningTotal(New(100, 0))
+	assert.Equal(t, "99.99", r.Add(New(-1, -2)).String())
+	assert.Equal(t, "99.99", r.Total().String())
+}
@@ -0,0 +1,151 @@
+package decimal
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// RowScanner scans decimal columns of database rows reusing its buffers
+// between rows. Number and NullNumber columns are read as sql.RawBytes and
+// parsed with FromBytes. Byte slices are never retained by RowScanner or by
+// Scan methods of any type in this package, so values are not corrupted when
+// the driver reuses them for the next row. The zero value is ready to use.
+// RowScanner is not safe for concurrent use.
+type RowScanner struct {
+	raw    []sql.RawBytes
+	args   []interface{}
+	values []Number
+}
+
+// Scan copies columns of the current row into dest like sql.Rows.Scan does.
+// Destinations of type *Number and *NullNumber are parsed from raw column
+// bytes, NULL column scanned into *Number fails with error matching ErrNull.
+// Other destinations are passed to sql.Rows.Scan unchanged and may already be
+// written if scanning fails, *Number and *NullNumber destinations are assigned
+// only if all columns are scanned successfully.
+func (s *RowScanner) Scan(rows *sql.Rows, dest ...interface{}) error {
+	if cap(s.raw) < len(dest) {
+		s.raw = make([]sql.RawBytes, len(dest))
+		s.args = make([]interface{}, len(dest))
+		s.values = make([]Number, len(dest))
+	}
+	s.raw, s.args, s.values = s.raw[:len(dest)], s.args[:len(dest)], s.values[:len(dest)]
+	for i, d := range dest {
+		switch d.(type) {
+		case *Number, *NullNumber:
+			s.args[i] = &s.raw[i]
+		default:
+			s.args[i] = d
+		}
+	}
+	if err := rows.Scan(s.args...); err != nil {
+		return err
+	}
+
+	// parse all columns before assigning any of them
+	values := s.values
+	for i, d := range dest {
+		if s.args[i] != &s.raw[i] {
+			continue
+		}
+		if s.raw[i] == nil {
+			if _, ok := d.(*Number); ok {
+				return fmt.Errorf("%w: column %d", ErrNull, i)
+			}
+			values[i] = Number{}
+			continue
+		}
+		n, err := FromBytes(s.raw[i])
+		if err != nil {
+			return fmt.Errorf("column %d: %w", i, err)
+		}
+		values[i] = n
+	}
+	for i, d := range dest {
+		switch d := d.(type) {
+		case *Number:
+			*d = values[i]
+		case *NullNumber:
+			*d = NullNumber{Decimal: values[i], Valid: s.raw[i] != nil}
+		}
+	}
+	return nil
+}
+
+// ScanRow copies columns of the current row into dest, see RowScanner.Scan.
+// Use RowScanner directly to reuse buffers when scanning many rows.
+func ScanRow(rows *sql.Rows, dest ...interface{}) error {
+	var s RowScanner
+	return s.Scan(rows, dest...)
+}
+
+// scanValue converts database driver value to a decimal number. In addition
+// to values accepted by Number.Scan it accepts values returned by non-standard
+// drivers, see scanInteger, *big.Rat having a finite decimal representation
+// and driver.Valuer values. Rationals needing rounding fail with
+// ErrPrecisionLoss.
+func scanValue(value interface{}) (Number, error) {
+	if n, ok := scanInteger(value); ok {
+		return n, nil
+	}
+	switch v := value.(type) {
+	case *big.Rat:
+		if v != nil {
+			n, ok := NewFromRatExact(v)
+			if !ok {
+				return Number{}, fmt.Errorf("%w: %s has no finite decimal representation", ErrPrecisionLoss, v)
+			}
+			return n, nil
+		}
+	case Number:
+		return v, nil
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return Number{}, err
+		}
+		// conversion is not repeated for values nesting Valuers
+		if _, ok := dv.(driver.Valuer); !ok {
+			return scanValue(dv)
+		}
+	}
+
+	var n Number
+	if err := n.Scan(value); err != nil {
+		return Number{}, err
+	}
+	return n, nil
+}
+
+// scanInteger converts integer driver value to a decimal number exactly. It
+// accepts values of any signed or unsigned integer kind, e.g. uint64 returned
+// for DECIMAL(20,0) columns, as well as *big.Int and big.Int values.
+func scanInteger(value interface{}) (Number, bool) {
+	switch v := value.(type) {
+	case int64:
+		return New(v, 0), true
+	case uint64:
+		return FromUint64(v), true
+	case *big.Int:
+		if v == nil {
+			return Number{}, false
+		}
+		return newDecimal.NewFromBigInt(v, 0), true
+	case big.Int:
+		return newDecimal.NewFromBigInt(&v, 0), true
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return FromInt64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return FromUint64(rv.Uint()), true
+	}
+	return Number{}, false
+}
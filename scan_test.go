@@ -0,0 +1,208 @@
+package decimal
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// reuseDriver is a database driver returning all byte slice values of a row
+// from a single buffer that is overwritten by the next row.
+type reuseDriver struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (d reuseDriver) Open(string) (driver.Conn, error) { return reuseConn{d}, nil }
+
+type reuseConn struct{ d reuseDriver }
+
+func (c reuseConn) Prepare(string) (driver.Stmt, error) { return reuseStmt(c), nil }
+func (c reuseConn) Close() error                        { return nil }
+func (c reuseConn) Begin() (driver.Tx, error)           { return nil, driver.ErrSkip }
+
+type reuseStmt struct{ d reuseDriver }
+
+func (s reuseStmt) Close() error                               { return nil }
+func (s reuseStmt) NumInput() int                              { return 0 }
+func (s reuseStmt) Exec([]driver.Value) (driver.Result, error) { return nil, driver.ErrSkip }
+func (s reuseStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &reuseRows{d: s.d, buf: make([]byte, 0, 64)}, nil
+}
+
+type reuseRows struct {
+	d   reuseDriver
+	i   int
+	buf []byte
+}
+
+func (r *reuseRows) Columns() []string { return r.d.columns }
+func (r *reuseRows) Close() error      { return nil }
+func (r *reuseRows) Next(dest []driver.Value) error {
+	if r.i == len(r.d.rows) {
+		return io.EOF
+	}
+	r.buf = r.buf[:0]
+	for i, v := range r.d.rows[r.i] {
+		if b, ok := v.([]byte); ok {
+			start := len(r.buf)
+			r.buf = append(r.buf, b...)
+			v = r.buf[start:len(r.buf):len(r.buf)]
+		}
+		dest[i] = v
+	}
+	r.i++
+	return nil
+}
+
+var reuseDrivers int
+
+func openReuse(t *testing.T, columns []string, rows ...[]driver.Value) *sql.Rows {
+	reuseDrivers++
+	name := "decimal-reuse-" + string(rune('a'+reuseDrivers))
+	sql.Register(name, reuseDriver{columns: columns, rows: rows})
+	db, err := sql.Open(name, "")
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	res, err := db.Query("")
+	assert.NoError(t, err)
+	return res
+}
+
+func TestRowScanner(t *testing.T) {
+	rows := openReuse(t, []string{"id", "stake", "odds", "payout"},
+		[]driver.Value{int64(1), []byte("10.50"), []byte("1.5"), []byte("15.75")},
+		[]driver.Value{int64(2), []byte("99999.99"), nil, nil},
+		[]driver.Value{int64(3), []byte("0.01"), []byte("123.456"), []byte("-1")},
+	)
+	defer rows.Close()
+
+	type row struct {
+		id     int64
+		stake  Number
+		odds   NullNumber
+		payout NullNumber
+	}
+	var actual []row
+	var s RowScanner
+	for rows.Next() {
+		var r row
+		assert.NoError(t, s.Scan(rows, &r.id, &r.stake, &r.odds, &r.payout))
+		actual = append(actual, r)
+	}
+	assert.NoError(t, rows.Err())
+
+	assert.Equal(t, []row{
+		{1, New(1050, -2), NullNumber{Decimal: New(15, -1), Valid: true}, NullNumber{Decimal: New(1575, -2), Valid: true}},
+		{2, New(9999999, -2), NullNumber{}, NullNumber{}},
+		{3, New(1, -2), NullNumber{Decimal: New(123456, -3), Valid: true}, NullNumber{Decimal: New(-1, 0), Valid: true}},
+	}, actual)
+}
+
+func TestScanRowError(t *testing.T) {
+	rows := openReuse(t, []string{"a", "b"},
+		[]driver.Value{[]byte("1"), nil},
+		[]driver.Value{[]byte("1"), []byte("x")},
+	)
+	defer rows.Close()
+
+	a, b := New(7, 0), New(7, 0)
+	assert.True(t, rows.Next())
+	assert.ErrorIs(t, ScanRow(rows, &a, &b), ErrNull)
+	assert.Equal(t, New(7, 0), a)
+
+	assert.True(t, rows.Next())
+	err := ScanRow(rows, &a, &b)
+	var perr *ParseError
+	assert.ErrorAs(t, err, &perr)
+	assert.Equal(t, New(7, 0), a)
+	assert.Equal(t, New(7, 0), b)
+
+	assert.Error(t, ScanRow(rows, &a))
+}
+
+func TestScanCopiesBytes(t *testing.T) {
+	buf := []byte("12.34")
+	overwrite := func() { copy(buf, "99.99") }
+
+	var n Number
+	assert.NoError(t, n.Scan(buf))
+	var nn NullNumber
+	assert.NoError(t, nn.Scan(buf))
+	var o Optional
+	assert.NoError(t, o.Scan(buf))
+	var e Extended
+	assert.NoError(t, e.Scan(buf))
+	c, err := DefaultConfig().Scan(buf)
+	assert.NoError(t, err)
+	overwrite()
+
+	expected := New(1234, -2)
+	assert.Equal(t, expected, n)
+	assert.Equal(t, expected, nn.Decimal)
+	assert.Equal(t, expected, o.Number)
+	assert.Equal(t, ExtendedFinite(expected), e)
+	assert.Equal(t, expected, c)
+}
+
+// bigValue is a custom driver value type holding a big integer.
+type bigValue struct{ v *big.Int }
+
+func (b bigValue) Value() (driver.Value, error) { return b.v.String(), nil }
+
+func TestScanValue(t *testing.T) {
+	type dec20 uint64
+
+	tests := []struct {
+		value    interface{}
+		expected string
+	}{
+		{int64(-5), "-5"},
+		{uint64(math.MaxUint64), "18446744073709551615"},
+		{dec20(math.MaxUint64), "18446744073709551615"},
+		{uint32(7), "7"},
+		{int8(-7), "-7"},
+		{big.NewInt(-12), "-12"},
+		{*new(big.Int).Lsh(big.NewInt(1), 100), "1267650600228229401496703205376"},
+		{big.NewRat(1, 8), "0.125"},
+		{bigValue{big.NewInt(42)}, "42"},
+		{New(15, -1), "1.5"},
+		{"1.25", "1.25"},
+		{[]byte("2.5"), "2.5"},
+		{0.5, "0.5"},
+	}
+
+	for _, test := range tests {
+		n, err := scanValue(test.value)
+		assert.NoError(t, err, "%T", test.value)
+		assert.Equal(t, test.expected, n.String(), "%T", test.value)
+	}
+
+	_, err := scanValue(big.NewRat(1, 3))
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	_, err = scanValue((*big.Int)(nil))
+	assert.Error(t, err)
+	_, err = scanValue(true)
+	assert.Error(t, err)
+
+	// scanners use the same conversion
+	c := DefaultConfig()
+	n, err := c.Scan(uint64(math.MaxUint64))
+	assert.NoError(t, err)
+	assert.Equal(t, FromUint64(math.MaxUint64), n)
+	c.Bounds.MaxCoefficientDigits = 19
+	_, err = c.Scan(uint64(math.MaxUint64))
+	assert.ErrorIs(t, err, ErrOutOfBounds)
+
+	var o Optional
+	assert.NoError(t, o.Scan(big.NewInt(3)))
+	assert.Equal(t, Optional{Number: New(3, 0), Valid: true, Present: true}, o)
+	var e Extended
+	assert.NoError(t, e.Scan(uint64(3)))
+	assert.Equal(t, ExtendedFinite(New(3, 0)), e)
+}
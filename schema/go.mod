@@ -0,0 +1,18 @@
+module github.com/advbet/decimal/v2/schema
+
+go 1.20
+
+replace github.com/advbet/decimal/v2 => ../
+
+require (
+	github.com/advbet/decimal/v2 v2.0.0-00010101000000-000000000000
+	github.com/gorilla/schema v1.4.1
+	github.com/stretchr/testify v1.8.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/shopspring/decimal v1.3.1 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
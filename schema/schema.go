@@ -0,0 +1,84 @@
+// Package schema registers decimal converters with github.com/gorilla/schema,
+// so form and query parameters such as ?min_odds=1.50 bind directly to
+// Number, NullNumber and Optional fields. It is also the way to bind query
+// parameters with go-chi, go-chi/render decodes form bodies with
+// encoding.TextUnmarshaler and needs no registration.
+//
+// gorilla/schema treats slices of struct types as nested forms, so slice
+// fields of decimal types are not supported.
+package schema
+
+import (
+	"reflect"
+
+	"github.com/advbet/decimal/v2"
+	gschema "github.com/gorilla/schema"
+)
+
+// Register registers decimal converters with d. Values are parsed with
+// decimal.FromString. Empty value is an invalid Number and null NullNumber or
+// Optional.
+func Register(d *gschema.Decoder) {
+	RegisterConfig(d, decimal.DefaultConfig())
+}
+
+// RegisterConfig registers decimal converters with d parsing values with
+// c.Parse, so Strict and bounds of c apply to untrusted input.
+func RegisterConfig(d *gschema.Decoder, c decimal.Config) {
+	d.RegisterConverter(decimal.Number{}, func(s string) reflect.Value {
+		n, err := c.Parse(s)
+		if err != nil {
+			return reflect.Value{}
+		}
+		return reflect.ValueOf(n)
+	})
+	d.RegisterConverter(decimal.NullNumber{}, func(s string) reflect.Value {
+		if s == "" {
+			return reflect.ValueOf(decimal.NullNumber{})
+		}
+		n, err := c.Parse(s)
+		if err != nil {
+			return reflect.Value{}
+		}
+		return reflect.ValueOf(decimal.NullNumber{Decimal: n, Valid: true})
+	})
+	d.RegisterConverter(decimal.Optional{}, func(s string) reflect.Value {
+		if s == "" {
+			return reflect.ValueOf(decimal.OptionalNull())
+		}
+		n, err := c.Parse(s)
+		if err != nil {
+			return reflect.Value{}
+		}
+		return reflect.ValueOf(decimal.OptionalValue(n))
+	})
+}
+
+// RegisterEncoder registers decimal encoders with e. Numbers are encoded as
+// exact decimal strings, null NullNumber and Optional as empty strings.
+func RegisterEncoder(e *gschema.Encoder) {
+	e.RegisterEncoder(decimal.Number{}, func(v reflect.Value) string {
+		return decimal.String(v.Interface().(decimal.Number))
+	})
+	e.RegisterEncoder(decimal.NullNumber{}, func(v reflect.Value) string {
+		n := v.Interface().(decimal.NullNumber)
+		if !n.Valid {
+			return ""
+		}
+		return decimal.String(n.Decimal)
+	})
+	e.RegisterEncoder(decimal.Optional{}, func(v reflect.Value) string {
+		n, ok := v.Interface().(decimal.Optional).Get()
+		if !ok {
+			return ""
+		}
+		return decimal.String(n)
+	})
+}
+
+// NewDecoder returns a new decoder having decimal converters registered.
+func NewDecoder() *gschema.Decoder {
+	d := gschema.NewDecoder()
+	Register(d)
+	return d
+}
@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	gschema "github.com/gorilla/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type query struct {
+	MinOdds decimal.Number     `schema:"min_odds"`
+	MaxOdds decimal.NullNumber `schema:"max_odds"`
+	Stake   decimal.Optional   `schema:"stake"`
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected query
+	}{
+		{
+			query: "min_odds=1.50&max_odds=2.5&stake=10",
+			expected: query{
+				MinOdds: decimal.New(150, -2),
+				MaxOdds: decimal.NullNumber{Decimal: decimal.New(25, -1), Valid: true},
+				Stake:   decimal.OptionalValue(decimal.New(10, 0)),
+			},
+		},
+		{
+			query:    "min_odds=-1e2&max_odds=&stake=",
+			expected: query{MinOdds: decimal.New(-1, 2), Stake: decimal.OptionalNull()},
+		},
+		{
+			query:    "",
+			expected: query{},
+		},
+	}
+
+	for _, test := range tests {
+		values, err := url.ParseQuery(test.query)
+		assert.NoError(t, err)
+		var actual query
+		assert.NoError(t, NewDecoder().Decode(&actual, values), test.query)
+		assert.Equal(t, test.expected, actual, test.query)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	for _, q := range []string{"min_odds=", "min_odds=x", "max_odds=1..5", "stake=1,5"} {
+		values, err := url.ParseQuery(q)
+		assert.NoError(t, err)
+		var actual query
+		err = NewDecoder().Decode(&actual, values)
+		var multi gschema.MultiError
+		assert.ErrorAs(t, err, &multi, q)
+	}
+}
+
+func TestRegisterConfig(t *testing.T) {
+	d := gschema.NewDecoder()
+	RegisterConfig(d, decimal.Config{Strict: true, Bounds: decimal.Bounds{MinExponent: -2, LimitMinExponent: true}})
+
+	var actual query
+	assert.NoError(t, d.Decode(&actual, url.Values{"min_odds": {"1.25"}}))
+	assert.Equal(t, decimal.New(125, -2), actual.MinOdds)
+	assert.Error(t, d.Decode(&actual, url.Values{"min_odds": {"1e2"}}))
+	assert.Error(t, d.Decode(&actual, url.Values{"min_odds": {"1.255"}}))
+	assert.Error(t, d.Decode(&actual, url.Values{"max_odds": {"1e-2147483648"}}))
+}
+
+func TestEncode(t *testing.T) {
+	e := gschema.NewEncoder()
+	RegisterEncoder(e)
+
+	values := url.Values{}
+	assert.NoError(t, e.Encode(query{
+		MinOdds: decimal.New(150, -2),
+		Stake:   decimal.OptionalValue(decimal.New(5, 0)),
+	}, values))
+	assert.Equal(t, url.Values{
+		"min_odds": {"1.5"},
+		"max_odds": {""},
+		"stake":    {"5"},
+	}, values)
+
+	var actual query
+	assert.NoError(t, NewDecoder().Decode(&actual, values))
+	assert.Equal(t, decimal.New(15, -1), actual.MinOdds)
+	assert.False(t, actual.MaxOdds.Valid)
+}
@@ -0,0 +1,40 @@
+package decimal
+
+import (
+	"sort"
+)
+
+// SearchSorted searches for target in values sorted in ascending order. It
+// returns index of the first value equal to target and true, or index where
+// target would be inserted to keep the order and false. Comparisons are
+// numeric, e.g. 1.5 is found in a slice holding 1.50.
+func SearchSorted(values []Number, target Number) (int, bool) {
+	i := sort.Search(len(values), func(i int) bool {
+		return Cmp(values[i], target) >= 0
+	})
+	return i, i < len(values) && Cmp(values[i], target) == 0
+}
+
+// FloorIn returns the largest value less than or equal to target from values
+// sorted in ascending order. It returns false if all values are larger than
+// target.
+func FloorIn(values []Number, target Number) (Number, bool) {
+	i := sort.Search(len(values), func(i int) bool {
+		return Cmp(values[i], target) > 0
+	})
+	if i == 0 {
+		return Number{}, false
+	}
+	return values[i-1], true
+}
+
+// CeilIn returns the smallest value greater than or equal to target from
+// values sorted in ascending order. It returns false if all values are smaller
+// than target.
+func CeilIn(values []Number, target Number) (Number, bool) {
+	i, _ := SearchSorted(values, target)
+	if i == len(values) {
+		return Number{}, false
+	}
+	return values[i], true
+}
@@ -0,0 +1,80 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchSorted(t *testing.T) {
+	values := []Number{New(101, -2), New(105, -2), New(11, -1), New(11, -1), New(2, 0)}
+	tests := []struct {
+		target Number
+		index  int
+		found  bool
+	}{
+		{New(1, 0), 0, false},
+		{New(101, -2), 0, true},
+		{New(1010, -3), 0, true},
+		{New(102, -2), 1, false},
+		{New(110, -2), 2, true},
+		{New(2, 0), 4, true},
+		{New(3, 0), 5, false},
+	}
+
+	for _, test := range tests {
+		index, found := SearchSorted(values, test.target)
+		assert.Equal(t, test.index, index, test.target.String())
+		assert.Equal(t, test.found, found, test.target.String())
+	}
+
+	index, found := SearchSorted(nil, One)
+	assert.Equal(t, 0, index)
+	assert.False(t, found)
+}
+
+func TestFloorCeilIn(t *testing.T) {
+	values := []Number{New(101, -2), New(105, -2), New(11, -1), New(2, 0)}
+	tests := []struct {
+		target  Number
+		floor   string
+		floorOK bool
+		ceil    string
+		ceilOK  bool
+	}{
+		{New(1, 0), "", false, "1.01", true},
+		{New(101, -2), "1.01", true, "1.01", true},
+		{New(1051, -3), "1.05", true, "1.1", true},
+		{New(19, -1), "1.1", true, "2", true},
+		{New(20, -1), "2", true, "2", true},
+		{New(21, -1), "2", true, "", false},
+	}
+
+	for _, test := range tests {
+		floor, ok := FloorIn(values, test.target)
+		assert.Equal(t, test.floorOK, ok, test.target.String())
+		if ok {
+			assert.Equal(t, test.floor, floor.String(), test.target.String())
+		}
+
+		ceil, ok := CeilIn(values, test.target)
+		assert.Equal(t, test.ceilOK, ok, test.target.String())
+		if ok {
+			assert.Equal(t, test.ceil, ceil.String(), test.target.String())
+		}
+	}
+}
+
+func BenchmarkFloorIn(b *testing.B) {
+	values := make([]Number, 350)
+	for i := range values {
+		values[i] = New(int64(101+i), -2)
+	}
+	target := New(2345, -3)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = FloorIn(values, target)
+	}
+}
@@ -0,0 +1,63 @@
+//go:build go1.21
+
+package decimal
+
+import (
+	"log/slog"
+)
+
+// LogNumber is a decimal number implementing the slog.LogValuer interface,
+// it is logged as the exact decimal string, e.g.
+//
+//	slog.Info("bet placed", "stake", decimal.LogNumber(stake))
+type LogNumber Number
+
+// LogValue implements the slog.LogValuer interface.
+func (n LogNumber) LogValue() slog.Value {
+	return slog.StringValue(String(Number(n)))
+}
+
+// LogNullNumber is a nullable decimal number implementing the slog.LogValuer
+// interface, null is logged as nil and other values as exact decimal strings.
+type LogNullNumber NullNumber
+
+// LogValue implements the slog.LogValuer interface.
+func (n LogNullNumber) LogValue() slog.Value {
+	if !n.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(String(n.Decimal))
+}
+
+// LogNumbers is a list of decimal numbers implementing the slog.LogValuer
+// interface, it is logged as a list of exact decimal strings.
+type LogNumbers []Number
+
+// LogValue implements the slog.LogValuer interface.
+func (ns LogNumbers) LogValue() slog.Value {
+	strs := make([]string, len(ns))
+	for i, n := range ns {
+		strs[i] = String(n)
+	}
+	return slog.AnyValue(strs)
+}
+
+// LogValue implements the slog.LogValuer interface.
+func (p Percent) LogValue() slog.Value {
+	return slog.StringValue(p.String())
+}
+
+// LogValue implements the slog.LogValuer interface.
+func (b BasisPoints) LogValue() slog.Value {
+	return slog.StringValue(b.String())
+}
+
+// LogValue implements the slog.LogValuer interface.
+func (f FixedScale) LogValue() slog.Value {
+	return slog.StringValue(f.String())
+}
+
+// LogValue implements the slog.LogValuer interface.
+func (e Extended) LogValue() slog.Value {
+	return slog.StringValue(e.String())
+}
@@ -0,0 +1,54 @@
+//go:build go1.21
+
+package decimal
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogValue(t *testing.T) {
+	tests := []struct {
+		v        slog.LogValuer
+		expected string
+	}{
+		{LogNumber(newDecimal.RequireFromString("12.340")), `"12.34"`},
+		{LogNumber(newDecimal.RequireFromString("0.1")), `"0.1"`},
+		{LogNumber(newDecimal.RequireFromString("12345678901234567890.123456789")), `"12345678901234567890.123456789"`},
+		{LogNumber(Number{}), `"0"`},
+		{LogNullNumber(NullNumber{}), `null`},
+		{LogNullNumber(NullNumber{Decimal: New(-15, -1), Valid: true}), `"-1.5"`},
+		{LogNumbers{New(1, -1), New(25, 0)}, `["0.1","25"]`},
+		{LogNumbers(nil), `[]`},
+		{NewPercent(New(5, 0)), `"5%"`},
+		{NewBasisPoints(New(25, -1)), `"2.5bp"`},
+		{RoundFixedScale(New(1, 0), -2, RoundTruncate), `"1.00"`},
+		{NaN(), `"NaN"`},
+		{Inf(-1), `"-Infinity"`},
+	}
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+			ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+				if len(groups) == 0 && a.Key != "v" {
+					return slog.Attr{}
+				}
+				return a
+			},
+		}))
+		logger.Info("", "v", test.v)
+		assert.Equal(t, `{"v":`+test.expected+"}\n", buf.String())
+	}
+}
+
+func TestLogValueText(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	logger.Info("bet placed", "stake", LogNumber(New(1050, -2)))
+	assert.Contains(t, buf.String(), "stake=10.5")
+}
@@ -0,0 +1,66 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Google Spanner NUMERIC type limits.
+const (
+	SpannerNumericPrecision = 38 // Total number of digits
+	SpannerNumericScale     = 9  // Number of fractional digits
+)
+
+// CheckSpannerNumeric checks if n can be stored in a Spanner NUMERIC column
+// without rounding. It fails with ErrPrecisionLoss if n has more than 9
+// significant fractional digits and ErrOverflow if n has more than 29
+// integer digits.
+func CheckSpannerNumeric(n Number) error {
+	digits, exp := SignificantDigits(n)
+	if digits == 0 {
+		return nil
+	}
+	if exp < -SpannerNumericScale {
+		return fmt.Errorf("%w: %s has more than %d fractional digits", ErrPrecisionLoss, DebugString(n), SpannerNumericScale)
+	}
+	if digits+exp > SpannerNumericPrecision-SpannerNumericScale {
+		return fmt.Errorf("%w: %s does not fit Spanner NUMERIC", ErrOverflow, DebugString(n))
+	}
+	return nil
+}
+
+// ToSpannerNumeric converts n to big.Rat used by the Spanner client for
+// NUMERIC columns, e.g. spanner.NullNumeric. It fails like
+// CheckSpannerNumeric if n does not fit the column.
+func ToSpannerNumeric(n Number) (*big.Rat, error) {
+	if err := CheckSpannerNumeric(n); err != nil {
+		return nil, err
+	}
+	return n.Rat(), nil
+}
+
+// SpannerNumericString converts n to the string representation of Spanner
+// NUMERIC values having exactly 9 fractional digits, e.g. "12.340000000",
+// the same as spanner.NumericString returns. It fails like
+// CheckSpannerNumeric if n does not fit the column.
+func SpannerNumericString(n Number) (string, error) {
+	if err := CheckSpannerNumeric(n); err != nil {
+		return "", err
+	}
+	return n.StringFixed(SpannerNumericScale), nil
+}
+
+// FromSpannerNumeric converts NUMERIC value read by the Spanner client to a
+// decimal number. The result has at most 9 fractional digits, trailing zeros
+// are not added. It fails with ErrPrecisionLoss if r is not a terminating
+// decimal fraction or does not fit the column type.
+func FromSpannerNumeric(r *big.Rat) (Number, error) {
+	n, exact := NewFromRatExact(r)
+	if !exact {
+		return Number{}, fmt.Errorf("%w: %s is not a decimal fraction", ErrPrecisionLoss, r)
+	}
+	if err := CheckSpannerNumeric(n); err != nil {
+		return Number{}, err
+	}
+	return n, nil
+}
@@ -0,0 +1,63 @@
+package decimal
+
+import (
+	"math/big"
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckSpannerNumeric(t *testing.T) {
+	tests := []struct {
+		n   Number
+		err error
+	}{
+		{New(1234, -2), nil},
+		{New(123456789, -9), nil},
+		{New(1234567890, -10), nil},
+		{New(1234567891, -10), ErrPrecisionLoss},
+		{newDecimal.RequireFromString("99999999999999999999999999999.999999999"), nil},
+		{newDecimal.RequireFromString("-99999999999999999999999999999.999999999"), nil},
+		{New(1, 29), ErrOverflow},
+		{New(1, 2147483647), ErrOverflow},
+		{New(1, -2147483648), ErrPrecisionLoss},
+		{New(0, -2147483648), nil},
+		{Number{}, nil},
+	}
+
+	for _, test := range tests {
+		err := CheckSpannerNumeric(test.n)
+		if test.err == nil {
+			assert.NoError(t, err, DebugString(test.n))
+		} else {
+			assert.ErrorIs(t, err, test.err, DebugString(test.n))
+		}
+	}
+	assert.EqualError(t, CheckSpannerNumeric(New(1, 29)), `decimal: overflow: dec(coef=1, exp=29, "100000000000000000000000000000") does not fit Spanner NUMERIC`)
+}
+
+func TestSpannerNumericConversions(t *testing.T) {
+	r, err := ToSpannerNumeric(New(-1234, -2))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, r.Cmp(big.NewRat(-1234, 100)))
+	_, err = ToSpannerNumeric(New(1, -10))
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	s, err := SpannerNumericString(New(1234, -2))
+	assert.NoError(t, err)
+	assert.Equal(t, "12.340000000", s)
+	s, err = SpannerNumericString(New(5, 3))
+	assert.NoError(t, err)
+	assert.Equal(t, "5000.000000000", s)
+	_, err = SpannerNumericString(New(1, 30))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	n, err := FromSpannerNumeric(big.NewRat(-1234, 100))
+	assert.NoError(t, err)
+	assert.Equal(t, New(-1234, -2), n)
+	_, err = FromSpannerNumeric(big.NewRat(1, 3))
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	_, err = FromSpannerNumeric(big.NewRat(1, 1<<40))
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+}
@@ -0,0 +1,37 @@
+package decimal
+
+// KellyStake calculates recommended stake using the fractional Kelly
+// criterion: bankroll * fraction * (probability * odds - 1) / (odds - 1).
+// Fraction 1 is the full Kelly stake, 0.5 is half Kelly, etc. The stake is
+// calculated exactly and rounded once to the given exponent using the given
+// rounding rule. Zero is returned if the bet has no positive edge or odds are
+// not greater than 1.
+func KellyStake(bankroll, odds, probability Number, fraction Number, exp int, rule RoundRule) Number {
+	edge := probability.Mul(odds).Sub(One)
+	if odds.Cmp(One) <= 0 || !edge.IsPositive() || !fraction.IsPositive() {
+		return Round(Zero(), exp, rule)
+	}
+	return divRound(bankroll.Mul(fraction).Mul(edge), odds.Sub(One), exp, rule)
+}
+
+// FlatStake returns a fixed stake amount limited to the available bankroll.
+// Negative bankroll results in zero stake.
+func FlatStake(bankroll, amount Number) Number {
+	if !bankroll.IsPositive() {
+		return Zero()
+	}
+	if amount.Cmp(bankroll) > 0 {
+		return bankroll
+	}
+	return amount
+}
+
+// PercentageStake returns a fixed percentage of the bankroll rounded to the
+// given exponent using the given rounding rule. Negative bankroll results in
+// zero stake.
+func PercentageStake(bankroll Number, p Percent, exp int, rule RoundRule) Number {
+	if !bankroll.IsPositive() {
+		return Round(Zero(), exp, rule)
+	}
+	return p.ApplyTo(bankroll, exp, rule)
+}
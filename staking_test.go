@@ -0,0 +1,48 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKellyStake(t *testing.T) {
+	bankroll := New(1000, 0)
+	tests := []struct {
+		odds        Number
+		probability Number
+		fraction    Number
+		rule        RoundRule
+		expected    string
+	}{
+		// (0.55 * 2 - 1) / (2 - 1) = 0.1
+		{New(2, 0), New(55, -2), One, RoundBankers, "100"},
+		{New(2, 0), New(55, -2), New(5, -1), RoundBankers, "50"},
+		// (0.4 * 3 - 1) / 2 = 0.1
+		{New(3, 0), New(4, -1), One, RoundBankers, "100"},
+		// (0.35 * 3.1 - 1) / 2.1 = 0.0404761...
+		{New(31, -1), New(35, -2), One, RoundBankers, "40.48"},
+		{New(31, -1), New(35, -2), One, RoundTruncate, "40.47"},
+		{New(2, 0), New(5, -1), One, RoundBankers, "0"},
+		{New(2, 0), New(4, -1), One, RoundBankers, "0"},
+		{One, New(99, -2), One, RoundBankers, "0"},
+		{New(2, 0), New(55, -2), Zero(), RoundBankers, "0"},
+	}
+
+	for _, test := range tests {
+		actual := KellyStake(bankroll, test.odds, test.probability, test.fraction, -2, test.rule)
+		assert.Equal(t, test.expected, actual.String(), "odds %s p %s", test.odds, test.probability)
+		assert.Equal(t, int32(-2), actual.Exponent())
+	}
+}
+
+func TestFlatStake(t *testing.T) {
+	assert.Equal(t, "10", FlatStake(New(100, 0), New(10, 0)).String())
+	assert.Equal(t, "5.5", FlatStake(New(55, -1), New(10, 0)).String())
+	assert.Equal(t, "0", FlatStake(New(-5, 0), New(10, 0)).String())
+}
+
+func TestPercentageStake(t *testing.T) {
+	assert.Equal(t, "25.01", PercentageStake(New(1234, 0), NewPercent(New(2027, -3)), -2, RoundBankers).String())
+	assert.Equal(t, "0", PercentageStake(Zero(), NewPercent(New(2, 0)), -2, RoundBankers).String())
+}
@@ -0,0 +1,74 @@
+package decimal
+
+// PercentileMethod is enum type for specifying percentile calculation method.
+type PercentileMethod int
+
+// List of supported percentile methods
+const (
+	// PercentileLinear interpolates linearly between the two closest ranks,
+	// same as PERCENTILE.INC in spreadsheets.
+	PercentileLinear PercentileMethod = iota
+	// PercentileNearestRank picks the smallest value such that at least p
+	// percent of values are less than or equal to it.
+	PercentileNearestRank
+)
+
+// Median calculates median of values and rounds it to the given exponent using
+// the given rounding rule. For an even number of values median is the exact
+// mean of the two middle values. Median of no values is zero. Values are not
+// modified.
+func Median(values []Number, exp int, rule RoundRule) Number {
+	if len(values) == 0 {
+		return Zero()
+	}
+
+	sorted := sortedCopy(values)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return Round(sorted[mid], exp, rule)
+	}
+	// (a + b) / 2 = (a + b) * 5 * 10^-1 is always exact
+	return Round(MulInt(sorted[mid-1].Add(sorted[mid]), 5).Shift(-1), exp, rule)
+}
+
+// Percentile calculates p-th percentile of values using the given method and
+// rounds it to the given exponent using the given rounding rule, p must be
+// between 0 and 100 inclusive. Interpolation is exact, rounding is performed
+// once. Percentile of no values is zero. Values are not modified.
+func Percentile(values []Number, p Number, method PercentileMethod, exp int, rule RoundRule) (Number, error) {
+	if p.Sign() < 0 || CmpInt64(p, 100) > 0 {
+		return Number{}, ErrInvalidPercentile
+	}
+	if len(values) == 0 {
+		return Zero(), nil
+	}
+
+	sorted := sortedCopy(values)
+	if method == PercentileNearestRank {
+		// rank = ceil(p / 100 * n), at least 1
+		rank := Round(MulInt(p, len(sorted)).Shift(-2), 0, RoundCeil).IntPart()
+		if rank < 1 {
+			rank = 1
+		}
+		return Round(sorted[rank-1], exp, rule), nil
+	}
+
+	// h = p / 100 * (n - 1), result = v[floor(h)] + frac(h) * (v[floor(h)+1] - v[floor(h)])
+	h := MulInt(p, len(sorted)-1).Shift(-2)
+	lower := h.IntPart()
+	frac := h.Sub(New(lower, 0))
+	res := sorted[lower]
+	if frac.Sign() != 0 {
+		res = res.Add(frac.Mul(sorted[lower+1].Sub(res)))
+	}
+	return Round(res, exp, rule), nil
+}
+
+// sortedCopy returns values sorted in ascending order without modifying the
+// original slice.
+func sortedCopy(values []Number) []Number {
+	sorted := make([]Number, len(values))
+	copy(sorted, values)
+	Sort(sorted)
+	return sorted
+}
@@ -0,0 +1,77 @@
+package decimal
+
+import (
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func numbers(strs ...string) []Number {
+	res := make([]Number, len(strs))
+	for i, s := range strs {
+		res[i] = newDecimal.RequireFromString(s)
+	}
+	return res
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		values   []Number
+		expected Number
+	}{
+		{nil, Zero()},
+		{numbers("1.5"), New(150, -2)},
+		{numbers("3", "1", "2"), New(200, -2)},
+		{numbers("4", "1", "2", "3"), New(250, -2)},
+		{numbers("0.01", "0.02"), New(2, -2)},
+		{numbers("0.03", "-0.02"), New(0, -2)},
+		{numbers("0.03", "0.02"), New(2, -2)},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, Median(test.values, -2, RoundBankers), "%v", test.values)
+	}
+
+	values := numbers("3", "1", "2")
+	Median(values, 0, RoundBankers)
+	assert.Equal(t, numbers("3", "1", "2"), values)
+}
+
+func TestPercentile(t *testing.T) {
+	values := numbers("15", "20", "35", "40", "50")
+	tests := []struct {
+		p        string
+		method   PercentileMethod
+		expected string
+	}{
+		{"0", PercentileLinear, "15"},
+		{"25", PercentileLinear, "20"},
+		{"40", PercentileLinear, "29"},
+		{"50", PercentileLinear, "35"},
+		{"90", PercentileLinear, "46"},
+		{"100", PercentileLinear, "50"},
+		{"33.3", PercentileLinear, "24.98"},
+		{"0", PercentileNearestRank, "15"},
+		{"5", PercentileNearestRank, "15"},
+		{"30", PercentileNearestRank, "20"},
+		{"40", PercentileNearestRank, "20"},
+		{"50", PercentileNearestRank, "35"},
+		{"100", PercentileNearestRank, "50"},
+	}
+
+	for _, test := range tests {
+		actual, err := Percentile(values, newDecimal.RequireFromString(test.p), test.method, -2, RoundBankers)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, actual.String(), "p%s method %d", test.p, test.method)
+	}
+
+	_, err := Percentile(values, New(101, 0), PercentileLinear, -2, RoundBankers)
+	assert.ErrorIs(t, err, ErrInvalidPercentile)
+	_, err = Percentile(values, New(-1, -2), PercentileNearestRank, -2, RoundBankers)
+	assert.ErrorIs(t, err, ErrInvalidPercentile)
+
+	n, err := Percentile(nil, New(50, 0), PercentileLinear, -2, RoundBankers)
+	assert.NoError(t, err)
+	assert.Equal(t, Zero(), n)
+}
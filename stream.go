@@ -0,0 +1,109 @@
+package decimal
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// DecodeAll reads a stream of decimal numbers from r and calls fn for each of
+// them in order. The stream is either a JSON array, if its first non-space
+// character is '[', or newline-delimited numbers, see DecodeJSONArray and
+// DecodeLines. Decoding stops at the first error returned by fn, the error is
+// returned unchanged.
+func DecodeAll(r io.Reader, fn func(Number) error) error {
+	br := bufio.NewReader(r)
+	var space []byte
+	for {
+		b, err := br.ReadByte()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			space = append(space, b)
+			continue
+		}
+		_ = br.UnreadByte()
+
+		// leading whitespace is kept, so line numbers are not shifted
+		if b == '[' {
+			return DecodeJSONArray(br, fn)
+		}
+		return DecodeLines(io.MultiReader(bytes.NewReader(space), br), fn)
+	}
+}
+
+// DecodeLines reads newline-delimited decimal numbers from r and calls fn for
+// each of them in order. Surrounding whitespace is ignored, empty lines are
+// skipped. Lines are parsed with FromBytes without intermediate strings.
+// Parse errors are *ParseError wrapped with the line number. Decoding stops
+// at the first error returned by fn, the error is returned unchanged.
+func DecodeLines(r io.Reader, fn func(Number) error) error {
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		b := bytes.TrimSpace(s.Bytes())
+		if len(b) == 0 {
+			continue
+		}
+		n, err := FromBytes(b)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		if err := fn(n); err != nil {
+			return err
+		}
+	}
+	return s.Err()
+}
+
+// DecodeJSONArray reads a JSON array of decimal numbers from r and calls fn
+// for each element in order without decoding the whole array into memory.
+// Elements can be JSON numbers or JSON strings, numbers are used exactly
+// without float64 conversion. Parse errors are *ParseError wrapped with the
+// element index. Decoding stops at the first error returned by fn, the error
+// is returned unchanged. Data following the array is not read.
+func DecodeJSONArray(r io.Reader, fn func(Number) error) error {
+	d := json.NewDecoder(r)
+	d.UseNumber()
+
+	tok, err := d.Token()
+	if err != nil {
+		return err
+	}
+	if tok != json.Delim('[') {
+		return fmt.Errorf("%w: expected JSON array, got %v", ErrParse, tok)
+	}
+
+	for i := 0; d.More(); i++ {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		var str string
+		switch v := tok.(type) {
+		case json.Number:
+			str = v.String()
+		case string:
+			str = v
+		default:
+			return fmt.Errorf("element %d: %w: unexpected %v", i, ErrParse, tok)
+		}
+		n, err := FromString(str)
+		if err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+		if err := fn(n); err != nil {
+			return err
+		}
+	}
+
+	// closing bracket
+	_, err = d.Token()
+	return err
+}
@@ -0,0 +1,89 @@
+package decimal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func collect(ns *[]Number) func(Number) error {
+	return func(n Number) error {
+		*ns = append(*ns, n)
+		return nil
+	}
+}
+
+func TestDecodeLines(t *testing.T) {
+	var actual []Number
+	assert.NoError(t, DecodeLines(strings.NewReader("1.50\n\n  -2 \r\n1e3\n0.000000000000000000001"), collect(&actual)))
+	assert.Equal(t, []Number{New(150, -2), New(-2, 0), New(1, 3), New(1, -21)}, actual)
+
+	err := DecodeLines(strings.NewReader("1\n\nx\n"), collect(&actual))
+	assert.ErrorIs(t, err, ErrParse)
+	assert.EqualError(t, err, `line 3: decimal: can't parse "x": can't convert x to decimal`)
+}
+
+func TestDecodeJSONArray(t *testing.T) {
+	var actual []Number
+	assert.NoError(t, DecodeJSONArray(strings.NewReader(`[1.50, "-2", 1e3, 12345678901234567890.123456789]`), collect(&actual)))
+	assert.Equal(t, "[1.5 -2 1000 12345678901234567890.123456789]", fmt.Sprint(actual))
+	assert.Equal(t, int32(-2), actual[0].Exponent())
+
+	actual = nil
+	assert.NoError(t, DecodeJSONArray(strings.NewReader(`[]`), collect(&actual)))
+	assert.Empty(t, actual)
+
+	tests := []struct {
+		input string
+		err   string
+	}{
+		{`{"a": 1}`, "decimal: invalid number: expected JSON array, got {"},
+		{`[1, null]`, "element 1: decimal: invalid number: unexpected <nil>"},
+		{`[1, [2]]`, "element 1: decimal: invalid number: unexpected ["},
+		{`[1, "x"]`, `element 1: decimal: can't parse "x": can't convert x to decimal`},
+	}
+	for _, test := range tests {
+		err := DecodeJSONArray(strings.NewReader(test.input), collect(&actual))
+		assert.EqualError(t, err, test.err, test.input)
+	}
+	assert.Error(t, DecodeJSONArray(strings.NewReader(`[1, 2`), collect(&actual)))
+}
+
+func TestDecodeAll(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []Number
+	}{
+		{"", nil},
+		{" \n ", nil},
+		{"1\n2\n", []Number{New(1, 0), New(2, 0)}},
+		{"\n [1, \"2\"]", []Number{New(1, 0), New(2, 0)}},
+	}
+	for _, test := range tests {
+		var actual []Number
+		assert.NoError(t, DecodeAll(strings.NewReader(test.input), collect(&actual)), test.input)
+		assert.Equal(t, test.expected, actual, test.input)
+	}
+
+	// line numbers count leading empty lines
+	err := DecodeAll(strings.NewReader("\n\nx"), collect(new([]Number)))
+	assert.EqualError(t, err, `line 3: decimal: can't parse "x": can't convert x to decimal`)
+
+	// callback errors stop decoding
+	stop := errors.New("stop")
+	var n int
+	fn := func(Number) error {
+		n++
+		if n == 2 {
+			return stop
+		}
+		return nil
+	}
+	assert.Equal(t, stop, DecodeAll(strings.NewReader("1\n2\n3"), fn))
+	n = 0
+	assert.Equal(t, stop, DecodeAll(strings.NewReader("[1, 2, 3]"), fn))
+	assert.Equal(t, 2, n)
+}
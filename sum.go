@@ -0,0 +1,126 @@
+package decimal
+
+import (
+	"math/big"
+	"sort"
+
+	newDecimal "github.com/shopspring/decimal"
+)
+
+// SumSlice calculates exact sum of values and rounds it to the given exponent
+// using the given rounding rule. All values are accumulated in a single
+// big.Int and rounded once, which is considerably faster than chained Add
+// calls for long slices.
+func SumSlice(values []Number, exp int, rule RoundRule) Number {
+	return Round(sumExact(values), exp, rule)
+}
+
+// CumSum returns cumulative sums of values, i-th element of the result is the
+// exact sum of values[0] through values[i]. No rounding is performed.
+func CumSum(values []Number) []Number {
+	res := make([]Number, len(values))
+	total := Zero()
+	for i, v := range values {
+		total = total.Add(v)
+		res[i] = total
+	}
+	return res
+}
+
+// sumExact calculates exact sum of values, the result has the smallest
+// exponent of all values. Sum of no values is zero.
+func sumExact(values []Number) Number {
+	if len(values) == 0 {
+		return Zero()
+	}
+
+	exp := values[0].Exponent()
+	for _, v := range values[1:] {
+		if v.Exponent() < exp {
+			exp = v.Exponent()
+		}
+	}
+
+	acc := new(big.Int)
+	for _, v := range values {
+		c := v.Coefficient()
+		if diff := int64(v.Exponent()) - int64(exp); diff > 0 {
+			c.Mul(c, pow10(diff))
+		}
+		acc.Add(acc, c)
+	}
+
+	return newDecimal.NewFromBigInt(acc, exp)
+}
+
+// bucketSum accumulates exact sum of numbers in buckets of equal exponents,
+// so addends are never rescaled while accumulating. Coefficients fitting
+// int64 are added with integer arithmetic until the bucket total overflows.
+// Zero value is an empty sum.
+type bucketSum struct {
+	buckets []sumBucket
+	last    int
+}
+
+type sumBucket struct {
+	exp   int32
+	small int64
+	big   *big.Int
+}
+
+// add adds n to the sum.
+func (s *bucketSum) add(n Number) {
+	exp := n.Exponent()
+	if s.last >= len(s.buckets) || s.buckets[s.last].exp != exp {
+		s.last = s.find(exp)
+	}
+	b := &s.buckets[s.last]
+
+	c := n.Coefficient()
+	if c.IsInt64() {
+		v := c.Int64()
+		if sum := b.small + v; (v >= 0) == (sum >= b.small) {
+			b.small = sum
+			return
+		}
+		// spill the int64 total on overflow
+		b.big.Add(b.big, big.NewInt(b.small))
+		b.small = v
+		return
+	}
+	b.big.Add(b.big, c)
+}
+
+// find returns index of the bucket for exp, creating it if needed.
+func (s *bucketSum) find(exp int32) int {
+	for i := range s.buckets {
+		if s.buckets[i].exp == exp {
+			return i
+		}
+	}
+	s.buckets = append(s.buckets, sumBucket{exp: exp, big: new(big.Int)})
+	return len(s.buckets) - 1
+}
+
+// sum returns exact sum of all added numbers, the result has the smallest
+// exponent of all numbers. Sum of no numbers is zero.
+func (s *bucketSum) sum() Number {
+	if len(s.buckets) == 0 {
+		return Zero()
+	}
+
+	sort.Slice(s.buckets, func(i, j int) bool {
+		return s.buckets[i].exp > s.buckets[j].exp
+	})
+	acc := new(big.Int)
+	exp := s.buckets[0].exp
+	for _, b := range s.buckets {
+		if diff := int64(exp) - int64(b.exp); diff > 0 && acc.Sign() != 0 {
+			acc.Mul(acc, pow10(diff))
+		}
+		acc.Add(acc, b.big)
+		acc.Add(acc, big.NewInt(b.small))
+		exp = b.exp
+	}
+	return newDecimal.NewFromBigInt(acc, exp)
+}
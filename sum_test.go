@@ -0,0 +1,77 @@
+package decimal
+
+import (
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSumSlice(t *testing.T) {
+	values := []Number{New(1, -3), New(1, -3), New(1, -3), New(5, -1), New(2, 0), New(1, 2)}
+
+	assert.Equal(t, newDecimal.New(102503, -3), SumSlice(values, -3, RoundMath))
+	assert.Equal(t, newDecimal.New(10250, -2), SumSlice(values, -2, RoundMath))
+	assert.Equal(t, newDecimal.New(10251, -2), SumSlice(values, -2, RoundCeil))
+	assert.Equal(t, newDecimal.New(103, 0), SumSlice(values, 0, RoundMath))
+	assert.Equal(t, newDecimal.New(103, 0), SumSlice(values, 0, RoundBankers))
+	assert.Equal(t, newDecimal.New(2, 0), SumSlice([]Number{New(2, 0), New(5, -1)}, 0, RoundBankers))
+
+	assert.True(t, SumSlice(nil, -2, RoundMath).IsZero())
+	assert.Equal(t, int32(-2), SumSlice(nil, -2, RoundMath).Exponent())
+}
+
+func TestSumSliceMatchesAdd(t *testing.T) {
+	values := make([]Number, 0, 1000)
+	expected := Zero()
+	for i := 0; i < 1000; i++ {
+		v := New(int64(i*7919%1000-500), -(i % 5))
+		values = append(values, v)
+		expected = expected.Add(v)
+	}
+
+	assert.True(t, expected.Equal(SumSlice(values, -4, RoundTruncate)))
+	assert.True(t, expected.Equal(Numbers(values).Sum()))
+	assert.Equal(t, expected.Exponent(), Numbers(values).Sum().Exponent())
+}
+
+func BenchmarkSumSlice(b *testing.B) {
+	values := make([]Number, 100000)
+	for i := range values {
+		values[i] = New(int64(i), -2)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = SumSlice(values, -2, RoundBankers)
+	}
+}
+
+func BenchmarkNumberAddChain(b *testing.B) {
+	values := make([]Number, 100000)
+	for i := range values {
+		values[i] = New(int64(i), -2)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sum := Zero()
+		for _, v := range values {
+			sum = sum.Add(v)
+		}
+		_ = Round(sum, -2, RoundBankers)
+	}
+}
+
+func TestCumSum(t *testing.T) {
+	values := []Number{New(10, 0), New(-25, -1), New(1, -3), New(0, 0)}
+	expected := []string{"10", "7.5", "7.501", "7.501"}
+
+	res := CumSum(values)
+	assert.Len(t, res, len(values))
+	for i := range expected {
+		assert.Equal(t, expected[i], res[i].String())
+	}
+
+	assert.Empty(t, CumSum(nil))
+}
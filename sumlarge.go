@@ -0,0 +1,20 @@
+//go:build go1.23
+
+package decimal
+
+import (
+	"iter"
+)
+
+// SumLarge calculates exact sum of a sequence of numbers, the result has the
+// smallest exponent of all values. Sum of no values is zero. Values are
+// accumulated in buckets by exponent and the buckets are combined once at the
+// end, so a large total is never rescaled against small addends. It is
+// meant for sequences too long to be materialized as a slice for SumSlice.
+func SumLarge(values iter.Seq[Number]) Number {
+	var s bucketSum
+	for v := range values {
+		s.add(v)
+	}
+	return s.sum()
+}
@@ -0,0 +1,61 @@
+//go:build go1.23
+
+package decimal
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSumLarge(t *testing.T) {
+	tests := []struct {
+		values   []Number
+		expected Number
+	}{
+		{nil, New(0, 0)},
+		{[]Number{New(1, -2)}, New(1, -2)},
+		{[]Number{New(1, -2), New(5, 0), New(-3, -1), {}}, New(471, -2)},
+		{[]Number{New(1, 30), New(1, -30)}, New(1, 30).Add(New(1, -30))},
+		{[]Number{New(math.MaxInt64, 0), New(math.MaxInt64, 0), New(math.MinInt64, 0)}, New(math.MaxInt64, 0).Add(New(-1, 0))},
+		{[]Number{New(math.MinInt64, -2), New(math.MinInt64, -2), New(1, 0)}, New(math.MinInt64, -2).Mul(Two).Add(One)},
+	}
+
+	for _, test := range tests {
+		actual := SumLarge(slices.Values(test.values))
+		assert.True(t, test.expected.Equal(actual), "%s != %s", test.expected, actual)
+		assert.Equal(t, sumExact(test.values), actual)
+	}
+}
+
+func TestSumLargeMany(t *testing.T) {
+	values := make([]Number, 0, 10000)
+	for i := 0; i < 10000; i++ {
+		values = append(values, New(int64(i%7-3)*math.MaxInt64/5, -int(i%3)))
+	}
+	assert.Equal(t, sumExact(values), SumLarge(slices.Values(values)))
+}
+
+func BenchmarkSumLarge(b *testing.B) {
+	values := make([]Number, 100000)
+	for i := range values {
+		values[i] = New(int64(i), -6)
+	}
+	values[0] = New(1, 40)
+
+	b.Run("SumLarge", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			SumLarge(slices.Values(values))
+		}
+	})
+	b.Run("Add", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sum := Zero()
+			for _, v := range values {
+				sum = sum.Add(v)
+			}
+		}
+	})
+}
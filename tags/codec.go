@@ -0,0 +1,234 @@
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/advbet/decimal/v2"
+)
+
+var (
+	numberType   = reflect.TypeOf(decimal.Number{})
+	nullType     = reflect.TypeOf(decimal.NullNumber{})
+	optionalType = reflect.TypeOf(decimal.Optional{})
+)
+
+// Apply rounds tagged decimal fields of the struct pointed to by v in place.
+// It fails with ErrInvalidTag if a tag can not be parsed and with an error
+// matching decimal.ErrOverflow if a rounded value would have more than 1000
+// digits, e.g. 1e2147483647 with scale 2.
+func Apply(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("tags: Apply requires a non-nil pointer, got %T", v)
+	}
+	_, err := apply(rv.Elem(), false)
+	return err
+}
+
+// MarshalJSON returns JSON encoding of v with tagged decimal fields rounded.
+// Unlike Apply, v is not modified, values are rounded in a copy.
+func MarshalJSON(v interface{}) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return json.Marshal(v)
+	}
+
+	cp := reflect.New(rv.Type()).Elem()
+	cp.Set(rv)
+	if _, err := apply(cp, true); err != nil {
+		return nil, err
+	}
+	return json.Marshal(cp.Interface())
+}
+
+// UnmarshalJSON decodes JSON data into v and rounds tagged decimal fields of
+// the result.
+func UnmarshalJSON(data []byte, v interface{}) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	return Apply(v)
+}
+
+// apply walks v and rounds tagged fields of all reachable structs. If
+// copying is true, slices and pointers are replaced with rounded copies
+// instead of being modified, so values shared with the caller stay intact.
+// It reports whether anything was changed. v must be settable.
+func apply(v reflect.Value, copying bool) (bool, error) {
+	switch v.Kind() {
+	case reflect.Struct:
+		if isDecimal(v.Type()) {
+			return false, nil
+		}
+		changed := false
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			t, err := fieldTag(f)
+			if err != nil {
+				return false, err
+			}
+			c, err := applyField(v.Field(i), t, copying)
+			if err != nil {
+				return false, err
+			}
+			changed = changed || c
+		}
+		return changed, nil
+	case reflect.Ptr:
+		if v.IsNil() {
+			return false, nil
+		}
+		elem := v.Elem()
+		if copying {
+			elem = reflect.New(v.Type().Elem()).Elem()
+			elem.Set(v.Elem())
+		}
+		changed, err := apply(elem, copying)
+		if changed && copying {
+			v.Set(elem.Addr())
+		}
+		return changed, err
+	case reflect.Slice, reflect.Array:
+		return applyElems(v, copying, func(e reflect.Value) (bool, error) {
+			return apply(e, copying)
+		})
+	case reflect.Interface:
+		if v.IsNil() {
+			return false, nil
+		}
+		elem := reflect.New(v.Elem().Type()).Elem()
+		elem.Set(v.Elem())
+		changed, err := apply(elem, copying)
+		if changed {
+			v.Set(elem)
+		}
+		return changed, err
+	default:
+		return false, nil
+	}
+}
+
+// applyField rounds a single struct field according to its tag.
+func applyField(v reflect.Value, t Tag, copying bool) (bool, error) {
+	if !t.HasScale {
+		return apply(v, copying)
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() || !isDecimal(v.Type().Elem()) {
+			return apply(v, copying)
+		}
+		elem := reflect.New(v.Type().Elem()).Elem()
+		elem.Set(v.Elem())
+		changed, err := round(elem, t)
+		if err != nil || !changed {
+			return false, err
+		}
+		if copying {
+			v.Set(elem.Addr())
+		} else {
+			v.Elem().Set(elem)
+		}
+		return true, nil
+	case reflect.Slice, reflect.Array:
+		if !isDecimal(v.Type().Elem()) {
+			return apply(v, copying)
+		}
+		return applyElems(v, copying, func(e reflect.Value) (bool, error) {
+			return round(e, t)
+		})
+	default:
+		if !isDecimal(v.Type()) {
+			return apply(v, copying)
+		}
+		return round(v, t)
+	}
+}
+
+// applyElems runs fn on every element of slice or array v. If copying is
+// true, changed slices are replaced with copies.
+func applyElems(v reflect.Value, copying bool, fn func(reflect.Value) (bool, error)) (bool, error) {
+	if v.Kind() == reflect.Slice && v.IsNil() {
+		return false, nil
+	}
+
+	elems := v
+	if copying && v.Kind() == reflect.Slice {
+		elems = reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		reflect.Copy(elems, v)
+	}
+
+	changed := false
+	for i := 0; i < elems.Len(); i++ {
+		c, err := fn(elems.Index(i))
+		if err != nil {
+			return false, err
+		}
+		changed = changed || c
+	}
+	if changed && copying && v.Kind() == reflect.Slice {
+		v.Set(elems)
+	}
+	return changed, nil
+}
+
+// round rounds decimal value v according to the tag and reports whether it
+// was changed.
+func round(v reflect.Value, t Tag) (bool, error) {
+	switch n := v.Addr().Interface().(type) {
+	case *decimal.Number:
+		return roundNumber(n, t)
+	case *decimal.NullNumber:
+		if !n.Valid {
+			return false, nil
+		}
+		return roundNumber(&n.Decimal, t)
+	case *decimal.Optional:
+		if !n.Valid {
+			return false, nil
+		}
+		return roundNumber(&n.Number, t)
+	default:
+		return false, nil
+	}
+}
+
+// maxRoundDigits is the largest number of coefficient digits roundNumber
+// produces, larger results fail with decimal.ErrOverflow.
+const maxRoundDigits = 1000
+
+// roundNumber rounds n to the tag scale without rescaling across unbounded
+// exponent gaps, so decoded numbers with extreme exponents such as
+// 1e-2147483648 are rounded cheaply.
+func roundNumber(n *decimal.Number, t Tag) (bool, error) {
+	exp := int64(-t.Scale)
+	if int64(n.Exponent()) == exp {
+		return false, nil
+	}
+
+	digits, nexp := decimal.SignificantDigits(*n)
+	switch {
+	case digits == 0:
+		*n = decimal.New(0, int(exp))
+	case digits+nexp-exp > maxRoundDigits:
+		return false, fmt.Errorf("%w: %s has more than %d digits with scale %d", decimal.ErrOverflow, decimal.DebugString(*n), maxRoundDigits, t.Scale)
+	case digits+nexp < exp:
+		// 0 < |n| < 10^(exp-1) rounds like any other number in that range
+		*n = decimal.Round(decimal.New(int64(n.Sign()), int(exp-2)), int(exp), t.Rule)
+	default:
+		// exponents of n and the result differ by a bounded amount
+		*n = decimal.Round(*n, int(exp), t.Rule)
+	}
+	return true, nil
+}
+
+// isDecimal checks if t is one of the supported decimal value types.
+func isDecimal(t reflect.Type) bool {
+	return t == numberType || t == nullType || t == optionalType
+}
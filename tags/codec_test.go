@@ -0,0 +1,167 @@
+package tags
+
+import (
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+type leg struct {
+	Odds decimal.Number `json:"odds" decimal:"scale=2,round=floor"`
+}
+
+type bet struct {
+	Stake    decimal.Number     `json:"stake" decimal:"scale=2,round=bankers"`
+	Raw      decimal.Number     `json:"raw"`
+	Bonus    decimal.NullNumber `json:"bonus" decimal:"scale=1,round=math"`
+	Cap      decimal.Optional   `json:"cap" decimal:"scale=0,round=ceil"`
+	Fee      *decimal.Number    `json:"fee" decimal:"scale=2,round=math"`
+	Shares   []decimal.Number   `json:"shares" decimal:"scale=1,round=truncate"`
+	Legs     []leg              `json:"legs"`
+	Main     *leg               `json:"main"`
+	internal decimal.Number     `decimal:"scale=0"`
+}
+
+func num(s string) decimal.Number {
+	return newDecimal.RequireFromString(s)
+}
+
+func newBet() bet {
+	fee := num("0.125")
+	return bet{
+		Stake:    num("10.125"),
+		Raw:      num("1.23456"),
+		Bonus:    decimal.NullNumber{Decimal: num("2.25"), Valid: true},
+		Cap:      decimal.OptionalValue(num("99.01")),
+		Fee:      &fee,
+		Shares:   []decimal.Number{num("0.39"), num("-0.61")},
+		Legs:     []leg{{Odds: num("1.919")}, {Odds: num("2.5")}},
+		Main:     &leg{Odds: num("3.339")},
+		internal: num("0.5"),
+	}
+}
+
+func TestApply(t *testing.T) {
+	b := newBet()
+	assert.NoError(t, Apply(&b))
+
+	assert.Equal(t, num("10.12"), b.Stake)
+	assert.Equal(t, num("1.23456"), b.Raw)
+	assert.Equal(t, num("2.3"), b.Bonus.Decimal)
+	assert.Equal(t, num("100"), b.Cap.Number)
+	assert.Equal(t, num("0.13"), *b.Fee)
+	assert.Equal(t, []decimal.Number{num("0.3"), num("-0.6")}, b.Shares)
+	assert.Equal(t, num("1.91"), b.Legs[0].Odds)
+	assert.Equal(t, num("2.50"), b.Legs[1].Odds)
+	assert.Equal(t, num("3.33"), b.Main.Odds)
+	assert.Equal(t, num("0.5"), b.internal)
+}
+
+func TestApplyNull(t *testing.T) {
+	b := bet{}
+	assert.NoError(t, Apply(&b))
+	assert.False(t, b.Bonus.Valid)
+	assert.False(t, b.Cap.Present)
+	assert.Nil(t, b.Fee)
+	assert.Nil(t, b.Shares)
+	assert.Equal(t, decimal.New(0, -2), b.Stake)
+}
+
+func TestApplyErrors(t *testing.T) {
+	assert.Error(t, Apply(bet{}))
+	assert.Error(t, Apply((*bet)(nil)))
+
+	invalid := struct {
+		N decimal.Number `decimal:"scale=two"`
+	}{}
+	assert.ErrorIs(t, Apply(&invalid), ErrInvalidTag)
+}
+
+func TestMarshalJSON(t *testing.T) {
+	b := newBet()
+	data, err := MarshalJSON(b)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"stake": 10.12,
+		"raw": 1.23456,
+		"bonus": 2.3,
+		"cap": 100,
+		"fee": 0.13,
+		"shares": [0.3, -0.6],
+		"legs": [{"odds": 1.91}, {"odds": 2.5}],
+		"main": {"odds": 3.33}
+	}`, string(data))
+
+	// original value and everything it references is left intact
+	assert.Equal(t, newBet(), b)
+
+	data, err = MarshalJSON(&b)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"stake":10.12`)
+	assert.Equal(t, newBet(), b)
+
+	data, err = MarshalJSON([]interface{}{leg{Odds: num("1.999")}})
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"odds":1.99}]`, string(data))
+
+	data, err = MarshalJSON(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var b bet
+	err := UnmarshalJSON([]byte(`{"stake": "10.125", "raw": 1.23456, "shares": [0.99], "main": {"odds": 1.555}}`), &b)
+	assert.NoError(t, err)
+	assert.Equal(t, num("10.12"), b.Stake)
+	assert.Equal(t, num("1.23456"), b.Raw)
+	assert.Equal(t, []decimal.Number{num("0.9")}, b.Shares)
+	assert.Equal(t, num("1.55"), b.Main.Odds)
+
+	assert.Error(t, UnmarshalJSON([]byte(`{"stake": "x"}`), &b))
+}
+
+func TestApplyExtremeExponents(t *testing.T) {
+	// rescaling these numbers would need gigabytes of memory
+	tests := []struct {
+		n        string
+		rule     string
+		expected string
+	}{
+		{"1e-2147483648", "bankers", "0.00"},
+		{"-1e-2147483648", "math", "0.00"},
+		{"1e-2147483648", "ceil", "0.01"},
+		{"-1e-2147483648", "floor", "-0.01"},
+		{"-1e-2147483648", "ceil", "0.00"},
+		{"1e-2147483648", "truncate", "0.00"},
+		{"0e-2147483648", "bankers", "0.00"},
+		{"0e2147483647", "bankers", "0.00"},
+		{"12345e-2147483", "ceil", "0.01"},
+		{"1e900", "bankers", "1e900"},
+	}
+	for _, test := range tests {
+		n := num(test.n)
+		rounded, err := roundNumber(&n, Tag{HasScale: true, Scale: 2, Rule: roundRules[test.rule]})
+		assert.NoError(t, err, test.n)
+		assert.True(t, rounded, test.n)
+		assert.True(t, n.Equal(num(test.expected)), "%s %s: %s", test.n, test.rule, n)
+		assert.Equal(t, int32(-2), n.Exponent(), test.n)
+	}
+
+	for _, body := range []string{`{"stake":"1e-2147483648"}`, `{"stake":"-1e-2147483648"}`} {
+		var b bet
+		assert.NoError(t, UnmarshalJSON([]byte(body), &b), body)
+		assert.Equal(t, decimal.New(0, -2), b.Stake, body)
+	}
+
+	var b bet
+	err := UnmarshalJSON([]byte(`{"stake":"1e2147483647"}`), &b)
+	assert.ErrorIs(t, err, decimal.ErrOverflow)
+	assert.EqualError(t, err, "decimal: overflow: dec(coef=1, exp=2147483647) has more than 1000 digits with scale 2")
+	b = bet{Shares: []decimal.Number{num("1e2147483647")}}
+	assert.ErrorIs(t, Apply(&b), decimal.ErrOverflow)
+	_, err = MarshalJSON(bet{Stake: num("1e2147483647")})
+	assert.ErrorIs(t, err, decimal.ErrOverflow)
+}
@@ -0,0 +1,44 @@
+package tags
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// StatusCode returns HTTP status code of validation errors, 400 Bad Request.
+func (e *ValidationError) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// DecodeJSON decodes JSON body of the request into v and validates tagged
+// decimal fields of the result. Validation failures are returned as a
+// *ValidationError, decoding failures as returned by encoding/json.
+func DecodeJSON(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return err
+	}
+	return Validate(v)
+}
+
+// WriteError writes a 400 Bad Request response with a JSON body listing the
+// invalid fields if err is a *ValidationError, e.g.
+//
+//	{"errors":[{"field":"stake","value":"1.005","message":"more than 2 decimal places"}]}
+//
+// It returns false and writes nothing for other errors.
+func WriteError(w http.ResponseWriter, err error) bool {
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		return false
+	}
+
+	body, err := json.Marshal(verr)
+	if err != nil {
+		return false
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(verr.StatusCode())
+	_, _ = w.Write(body)
+	return true
+}
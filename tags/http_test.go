@@ -0,0 +1,48 @@
+package tags
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeJSON(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/bets", strings.NewReader(`{"stake": "10.50", "odds": [1.5]}`))
+	var v ticket
+	assert.NoError(t, DecodeJSON(r, &v))
+	assert.Equal(t, "10.5", v.Stake.String())
+
+	r = httptest.NewRequest(http.MethodPost, "/bets", strings.NewReader(`{"stake": "10.505"}`))
+	err := DecodeJSON(r, &v)
+	assert.ErrorIs(t, err, ErrInvalidField)
+
+	w := httptest.NewRecorder()
+	assert.True(t, WriteError(w, err))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"errors": [{"field": "stake", "value": "10.505", "message": "more than 2 decimal places"}]}`, w.Body.String())
+}
+
+func TestDecodeJSONMalformed(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/bets", strings.NewReader(`{"stake": `))
+	var v ticket
+	err := DecodeJSON(r, &v)
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrInvalidField))
+
+	w := httptest.NewRecorder()
+	assert.False(t, WriteError(w, err))
+	assert.Equal(t, 0, w.Body.Len())
+}
+
+func TestDecodeJSONExtremeExponent(t *testing.T) {
+	for _, body := range []string{`{"stake":"1e-2147483648"}`, `{"stake":"1e2147483647"}`} {
+		r := httptest.NewRequest(http.MethodPost, "/bets", strings.NewReader(body))
+		var v ticket
+		assert.ErrorIs(t, DecodeJSON(r, &v), ErrInvalidField, body)
+	}
+}
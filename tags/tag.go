@@ -0,0 +1,127 @@
+// Package tags applies rounding and validation policies declared in struct
+// tags to decimal fields, e.g.
+//
+//	type Bet struct {
+//		Stake  decimal.Number `json:"stake" decimal:"scale=2,round=bankers"`
+//		Payout decimal.Number `json:"payout" decimal:"scale=2,round=floor"`
+//	}
+//
+// Tagged fields of types decimal.Number, decimal.NullNumber and
+// decimal.Optional are rounded by Apply and checked by Validate, as well as
+// elements of tagged slices and arrays of these types. Nested structs,
+// pointers to structs and slices of structs are processed recursively, maps
+// are not.
+package tags
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/advbet/decimal/v2"
+)
+
+// ErrInvalidTag is returned when a decimal struct tag can not be parsed.
+var ErrInvalidTag = errors.New("tags: invalid decimal tag")
+
+// tagName is the struct tag key holding decimal field policies.
+const tagName = "decimal"
+
+// Tag is a parsed decimal struct tag.
+type Tag struct {
+	// HasScale is true if scale option is set.
+	HasScale bool
+	// Scale is the number of decimal places values are rounded to by Apply
+	// and the largest number of decimal places accepted by Validate.
+	Scale int
+	// Rule is the rounding rule, RoundTruncate if round option is not set.
+	Rule decimal.RoundRule
+	// HasPrecision is true if precision option is set.
+	HasPrecision bool
+	// Precision is the largest number of digits accepted by Validate, as in
+	// SQL NUMERIC(precision, scale). Scale is zero if it is not set.
+	Precision int
+	// HasMin is true if min option is set.
+	HasMin bool
+	// Min is the smallest value accepted by Validate.
+	Min decimal.Number
+	// HasMax is true if max option is set.
+	HasMax bool
+	// Max is the largest value accepted by Validate.
+	Max decimal.Number
+}
+
+// roundRules maps round option values to rounding rules.
+var roundRules = map[string]decimal.RoundRule{
+	"truncate": decimal.RoundTruncate,
+	"floor":    decimal.RoundFloor,
+	"ceil":     decimal.RoundCeil,
+	"math":     decimal.RoundMath,
+	"bankers":  decimal.RoundBankers,
+}
+
+// ParseTag parses a decimal struct tag value, a comma separated list of
+// key=value options:
+//
+//	scale=N      round to N decimal places, N might be negative
+//	round=RULE   rounding rule: truncate, floor, ceil, math or bankers
+//	precision=N  accept values having at most N digits
+//	min=X        accept values greater than or equal to X
+//	max=X        accept values less than or equal to X
+func ParseTag(tag string) (Tag, error) {
+	var t Tag
+	if tag == "" {
+		return t, nil
+	}
+
+	for _, opt := range strings.Split(tag, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(opt), "=")
+		if !ok {
+			return Tag{}, fmt.Errorf("%w: option %q has no value", ErrInvalidTag, opt)
+		}
+		switch key {
+		case "scale":
+			scale, err := strconv.Atoi(value)
+			if err != nil {
+				return Tag{}, fmt.Errorf("%w: scale %q", ErrInvalidTag, value)
+			}
+			t.HasScale, t.Scale = true, scale
+		case "round":
+			rule, ok := roundRules[value]
+			if !ok {
+				return Tag{}, fmt.Errorf("%w: round %q", ErrInvalidTag, value)
+			}
+			t.Rule = rule
+		case "precision":
+			precision, err := strconv.Atoi(value)
+			if err != nil || precision <= 0 {
+				return Tag{}, fmt.Errorf("%w: precision %q", ErrInvalidTag, value)
+			}
+			t.HasPrecision, t.Precision = true, precision
+		case "min", "max":
+			n, err := decimal.FromString(value)
+			if err != nil {
+				return Tag{}, fmt.Errorf("%w: %s %q", ErrInvalidTag, key, value)
+			}
+			if key == "min" {
+				t.HasMin, t.Min = true, n
+			} else {
+				t.HasMax, t.Max = true, n
+			}
+		default:
+			return Tag{}, fmt.Errorf("%w: unknown option %q", ErrInvalidTag, key)
+		}
+	}
+	return t, nil
+}
+
+// fieldTag parses decimal tag of a struct field.
+func fieldTag(f reflect.StructField) (Tag, error) {
+	t, err := ParseTag(f.Tag.Get(tagName))
+	if err != nil {
+		return Tag{}, fmt.Errorf("field %s: %w", f.Name, err)
+	}
+	return t, nil
+}
@@ -0,0 +1,37 @@
+package tags
+
+import (
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTag(t *testing.T) {
+	tests := []struct {
+		tag      string
+		expected Tag
+	}{
+		{"", Tag{}},
+		{"scale=2", Tag{HasScale: true, Scale: 2}},
+		{"scale=2,round=bankers", Tag{HasScale: true, Scale: 2, Rule: decimal.RoundBankers}},
+		{"round=ceil, scale=-1", Tag{HasScale: true, Scale: -1, Rule: decimal.RoundCeil}},
+		{"scale=0,round=floor", Tag{HasScale: true, Scale: 0, Rule: decimal.RoundFloor}},
+		{"scale=4,round=math", Tag{HasScale: true, Scale: 4, Rule: decimal.RoundMath}},
+		{"scale=4,round=truncate", Tag{HasScale: true, Scale: 4, Rule: decimal.RoundTruncate}},
+		{"precision=10,scale=2", Tag{HasScale: true, Scale: 2, HasPrecision: true, Precision: 10}},
+		{"min=1.01,max=1000", Tag{HasMin: true, Min: decimal.New(101, -2), HasMax: true, Max: decimal.New(1000, 0)}},
+		{"min=-5", Tag{HasMin: true, Min: decimal.New(-5, 0)}},
+	}
+
+	for _, test := range tests {
+		tag, err := ParseTag(test.tag)
+		assert.NoError(t, err, test.tag)
+		assert.Equal(t, test.expected, tag, test.tag)
+	}
+
+	for _, tag := range []string{"scale", "scale=x", "round=up", "scale=2,", "precision=0", "precision=x", "min=", "max=1.2.3", "limit=5"} {
+		_, err := ParseTag(tag)
+		assert.ErrorIs(t, err, ErrInvalidTag, tag)
+	}
+}
@@ -0,0 +1,212 @@
+package tags
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/advbet/decimal/v2"
+)
+
+// ErrInvalidField is matched by all *ValidationError errors.
+var ErrInvalidField = errors.New("tags: invalid decimal field")
+
+// FieldError describes a decimal field violating its tag policy.
+type FieldError struct {
+	Field   string `json:"field"`   // Field is the path of the field, e.g. "legs[0].odds"
+	Value   string `json:"value"`   // Value is the rejected value
+	Message string `json:"message"` // Message describes the violated policy
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationError lists all decimal fields violating their tag policies. It
+// matches ErrInvalidField when checked with errors.Is.
+type ValidationError struct {
+	Fields []FieldError `json:"errors"`
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Error()
+	}
+	return "tags: invalid decimal fields: " + strings.Join(msgs, "; ")
+}
+
+// Is reports whether the error matches ErrInvalidField.
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidField
+}
+
+// Validate checks tagged decimal fields of struct v, or the struct pointed to
+// by v, against scale, precision, min and max tag options. It returns a
+// *ValidationError listing all violating fields, or an error matching
+// ErrInvalidTag if a tag can not be parsed. Null values are not checked.
+// Field paths use JSON field names where defined.
+func Validate(v interface{}) error {
+	var verr ValidationError
+	if err := validate(reflect.ValueOf(v), "", &verr); err != nil {
+		return err
+	}
+	if len(verr.Fields) > 0 {
+		return &verr
+	}
+	return nil
+}
+
+// validate walks v and collects field errors of all reachable structs.
+func validate(v reflect.Value, path string, verr *ValidationError) error {
+	switch v.Kind() {
+	case reflect.Struct:
+		if isDecimal(v.Type()) {
+			return nil
+		}
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			t, err := fieldTag(f)
+			if err != nil {
+				return err
+			}
+			if err := validateField(v.Field(i), t, joinPath(path, fieldName(f)), verr); err != nil {
+				return err
+			}
+		}
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			return validate(v.Elem(), path, verr)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := validate(v.Index(i), path+"["+strconv.Itoa(i)+"]", verr); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateField checks a single struct field according to its tag.
+func validateField(v reflect.Value, t Tag, path string, verr *ValidationError) error {
+	if v.Kind() == reflect.Ptr && !v.IsNil() && isDecimal(v.Type().Elem()) {
+		v = v.Elem()
+	}
+
+	switch {
+	case isDecimal(v.Type()):
+		if n, ok := decimalValue(v); ok {
+			if msg := check(n, t); msg != "" {
+				verr.Fields = append(verr.Fields, FieldError{Field: path, Value: fieldValue(n), Message: msg})
+			}
+		}
+		return nil
+	case (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && isDecimal(v.Type().Elem()):
+		for i := 0; i < v.Len(); i++ {
+			if err := validateField(v.Index(i), t, path+"["+strconv.Itoa(i)+"]", verr); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return validate(v, path, verr)
+	}
+}
+
+// check returns description of the policy violated by n, empty string if n
+// satisfies the tag. Numbers are never rescaled, so inputs with extreme
+// exponents such as 1e-2147483648 are checked cheaply.
+func check(n decimal.Number, t Tag) string {
+	digits, exp := decimal.SignificantDigits(n)
+	if t.HasScale && digits > 0 && exp < int64(-t.Scale) {
+		return fmt.Sprintf("more than %d decimal places", t.Scale)
+	}
+	if t.HasPrecision && digits > 0 {
+		// |n| < 10^(precision-scale)
+		scale := 0
+		if t.HasScale {
+			scale = t.Scale
+		}
+		if digits+exp > int64(t.Precision-scale) {
+			return fmt.Sprintf("more than %d digits", t.Precision)
+		}
+	}
+	if t.HasMin && cmp(n, t.Min) < 0 {
+		return "less than " + t.Min.String()
+	}
+	if t.HasMax && cmp(n, t.Max) > 0 {
+		return "greater than " + t.Max.String()
+	}
+	return ""
+}
+
+// cmp compares x and y like decimal.Cmp, numbers of different magnitudes are
+// ordered without rescaling them.
+func cmp(x, y decimal.Number) int {
+	if x.Sign() != y.Sign() || x.Sign() == 0 {
+		return decimal.Cmp(x, y)
+	}
+	xd, xe := decimal.SignificantDigits(x)
+	yd, ye := decimal.SignificantDigits(y)
+	// 10^(m-1) <= |n| < 10^m for magnitude m = digits+exp
+	switch xm, ym := xd+xe, yd+ye; {
+	case xm < ym:
+		return -x.Sign()
+	case xm > ym:
+		return x.Sign()
+	default:
+		// exponents differ by no more than the number of digits
+		return decimal.Cmp(x, y)
+	}
+}
+
+// maxExp is the largest exponent magnitude of numbers that fieldValue formats
+// in decimal notation.
+const maxExp = 1000
+
+// fieldValue formats n for FieldError. Numbers with extreme exponents are
+// formatted in exponential notation, their decimal notation might not fit
+// into memory.
+func fieldValue(n decimal.Number) string {
+	if exp := n.Exponent(); exp > maxExp || exp < -maxExp {
+		return decimal.Key(n)
+	}
+	return n.String()
+}
+
+// decimalValue returns number held by decimal value v, false for null
+// values.
+func decimalValue(v reflect.Value) (decimal.Number, bool) {
+	switch n := v.Interface().(type) {
+	case decimal.Number:
+		return n, true
+	case decimal.NullNumber:
+		return n.Decimal, n.Valid
+	case decimal.Optional:
+		return n.Get()
+	default:
+		return decimal.Number{}, false
+	}
+}
+
+// fieldName returns JSON name of a struct field, Go name if it has none.
+func fieldName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return f.Name
+	}
+	return name
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,157 @@
+package tags
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type ticket struct {
+	Stake  decimal.Number     `json:"stake" decimal:"scale=2,precision=8,min=0.10,max=10000"`
+	Odds   []decimal.Number   `json:"odds" decimal:"min=1.01"`
+	Bonus  decimal.NullNumber `json:"bonus" decimal:"scale=0"`
+	Cap    *decimal.Number    `json:"cap,omitempty" decimal:"max=500"`
+	Legs   []leg              `json:"legs"`
+	Note   decimal.Number     `json:"-" decimal:"precision=2"`
+	Amount decimal.Optional   `decimal:"min=0"`
+}
+
+func TestValidate(t *testing.T) {
+	valid := ticket{
+		Stake: decimal.New(1050, -2),
+		Odds:  []decimal.Number{decimal.New(101, -2), decimal.New(25, -1)},
+		Legs:  []leg{{Odds: decimal.New(15, -1)}},
+	}
+	assert.NoError(t, Validate(valid))
+	assert.NoError(t, Validate(&valid))
+
+	capValue := decimal.New(501, 0)
+	invalid := ticket{
+		Stake:  decimal.New(10005, -3),
+		Odds:   []decimal.Number{decimal.New(101, -2), decimal.New(1, 0)},
+		Bonus:  decimal.NullNumber{Decimal: decimal.New(15, -1), Valid: true},
+		Cap:    &capValue,
+		Note:   decimal.New(100, 0),
+		Amount: decimal.OptionalValue(decimal.New(-1, 0)),
+	}
+	err := Validate(&invalid)
+	assert.ErrorIs(t, err, ErrInvalidField)
+
+	var verr *ValidationError
+	assert.True(t, errors.As(err, &verr))
+	assert.Equal(t, []FieldError{
+		{Field: "stake", Value: "10.005", Message: "more than 2 decimal places"},
+		{Field: "odds[1]", Value: "1", Message: "less than 1.01"},
+		{Field: "bonus", Value: "1.5", Message: "more than 0 decimal places"},
+		{Field: "cap", Value: "501", Message: "greater than 500"},
+		{Field: "Note", Value: "100", Message: "more than 2 digits"},
+		{Field: "Amount", Value: "-1", Message: "less than 0"},
+	}, verr.Fields)
+	assert.Equal(t, "tags: invalid decimal fields: stake: more than 2 decimal places; odds[1]: less than 1.01; "+
+		"bonus: more than 0 decimal places; cap: greater than 500; Note: more than 2 digits; Amount: less than 0", err.Error())
+}
+
+func TestValidateRanges(t *testing.T) {
+	tests := []struct {
+		stake string
+		msg   string
+	}{
+		{"0.10", ""},
+		{"0.1000", ""},
+		{"0.09", "less than 0.1"},
+		{"10000.00", ""},
+		{"10000.01", "greater than 10000"},
+		{"99999.99", "greater than 10000"},
+		{"1000000", "more than 8 digits"},
+		{"1.001", "more than 2 decimal places"},
+	}
+
+	for _, test := range tests {
+		err := Validate(ticket{Stake: num(test.stake)})
+		if test.msg == "" {
+			assert.NoError(t, err, test.stake)
+			continue
+		}
+		var verr *ValidationError
+		assert.True(t, errors.As(err, &verr), test.stake)
+		assert.Equal(t, test.msg, verr.Fields[0].Message, test.stake)
+	}
+}
+
+func TestValidateNested(t *testing.T) {
+	type slip struct {
+		Tickets []ticket `json:"tickets"`
+		Main    *ticket  `json:"main"`
+	}
+
+	s := slip{
+		Tickets: []ticket{
+			{Stake: num("1")},
+			{Stake: num("1"), Legs: []leg{{Odds: num("1.5")}}},
+		},
+		Main: &ticket{Stake: num("0.01")},
+	}
+	// leg odds tag has scale 2 and no range, 1.5 is valid
+	var verr *ValidationError
+	assert.True(t, errors.As(Validate(s), &verr))
+	assert.Equal(t, []FieldError{{Field: "main.stake", Value: "0.01", Message: "less than 0.1"}}, verr.Fields)
+
+	s.Tickets[1].Legs[0].Odds = num("1.555")
+	assert.True(t, errors.As(Validate(s), &verr))
+	assert.Equal(t, "tickets[1].legs[0].odds", verr.Fields[0].Field)
+}
+
+func TestValidateInvalidTag(t *testing.T) {
+	v := struct {
+		N decimal.Number `decimal:"min=abc"`
+	}{}
+	assert.ErrorIs(t, Validate(v), ErrInvalidTag)
+	assert.NoError(t, Validate(nil))
+}
+
+func TestValidateExtremeExponents(t *testing.T) {
+	// rescaling these numbers would need gigabytes of memory
+	tests := []struct {
+		stake string
+		msg   string
+	}{
+		{"1e-2147483648", "more than 2 decimal places"},
+		{"-1e-2147483648", "more than 2 decimal places"},
+		{"1e2147483647", "more than 8 digits"},
+		{"100e-2", ""},
+		{"1e3", ""},
+	}
+	for _, test := range tests {
+		err := Validate(ticket{Stake: num(test.stake)})
+		if test.msg == "" {
+			assert.NoError(t, err, test.stake)
+			continue
+		}
+		var verr *ValidationError
+		assert.True(t, errors.As(err, &verr), test.stake)
+		assert.Equal(t, []FieldError{{Field: "stake", Value: test.stake, Message: test.msg}}, verr.Fields, test.stake)
+	}
+
+	v := struct {
+		N decimal.Number `decimal:"min=1,max=100"`
+	}{}
+	for n, msg := range map[string]string{
+		"1e2147483647":   "greater than 100",
+		"-1e2147483647":  "less than 1",
+		"1e-2147483648":  "less than 1",
+		"12345e-2147483": "less than 1",
+		"99.99":          "",
+	} {
+		v.N = num(n)
+		err := Validate(v)
+		if msg == "" {
+			assert.NoError(t, err, n)
+			continue
+		}
+		var verr *ValidationError
+		assert.True(t, errors.As(err, &verr), n)
+		assert.Equal(t, msg, verr.Fields[0].Message, n)
+	}
+}
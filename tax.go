@@ -0,0 +1,31 @@
+package decimal
+
+// AddTax calculates tax on top of a net amount, e.g. VAT added to a net price.
+// Rate is a plain factor, i.e. 0.21 for 21%. Tax is rounded to the given
+// exponent using the given rounding rule and gross is net + tax, so the
+// amounts always reconcile exactly.
+func AddTax(net, rate Number, exp int, rule RoundRule) (gross, tax Number) {
+	tax = Round(net.Mul(rate), exp, rule)
+	return net.Add(tax), tax
+}
+
+// ExtractTax splits a gross amount that includes tax into net amount and tax,
+// e.g. VAT included in a consumer price. Rate is a plain factor, i.e. 0.21 for
+// 21%. Tax gross * rate / (1 + rate) is rounded to the given exponent using
+// the given rounding rule and net is gross - tax, so the amounts always
+// reconcile exactly.
+func ExtractTax(gross, rate Number, exp int, rule RoundRule) (net, tax Number) {
+	tax = divRound(gross.Mul(rate), One.Add(rate), exp, rule)
+	return gross.Sub(tax), tax
+}
+
+// GrossUp calculates gross amount that leaves the given net amount after a tax
+// withheld from the gross, e.g. betting duty deducted from winnings. Rate is a
+// plain factor less than 1, i.e. 0.15 for 15%. Gross net / (1 - rate) is
+// rounded to the given exponent using the given rounding rule and tax is
+// gross - net, so the amounts always reconcile exactly. It panics if rate is
+// 1.
+func GrossUp(net, rate Number, exp int, rule RoundRule) (gross, tax Number) {
+	gross = divRound(net, One.Sub(rate), exp, rule)
+	return gross, gross.Sub(net)
+}
@@ -0,0 +1,62 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddTax(t *testing.T) {
+	gross, tax := AddTax(New(1999, -2), New(21, -2), -2, RoundBankers)
+	assert.Equal(t, "4.2", tax.String())
+	assert.Equal(t, "24.19", gross.String())
+
+	gross, tax = AddTax(New(1, -2), New(5, -1), -2, RoundBankers)
+	assert.Equal(t, "0", tax.String())
+	assert.Equal(t, "0.01", gross.String())
+}
+
+func TestExtractTax(t *testing.T) {
+	tests := []struct {
+		gross Number
+		rate  Number
+		net   string
+		tax   string
+	}{
+		{New(12100, -2), New(21, -2), "100", "21"},
+		{New(1000, -2), New(21, -2), "8.26", "1.74"},
+		{New(1, -2), New(20, -2), "0.01", "0"},
+		{New(-1000, -2), New(21, -2), "-8.26", "-1.74"},
+	}
+
+	for _, test := range tests {
+		net, tax := ExtractTax(test.gross, test.rate, -2, RoundBankers)
+		assert.Equal(t, test.net, net.String())
+		assert.Equal(t, test.tax, tax.String())
+		assert.True(t, net.Add(tax).Equal(test.gross))
+	}
+}
+
+func TestGrossUp(t *testing.T) {
+	tests := []struct {
+		net   Number
+		rate  Number
+		rule  RoundRule
+		gross string
+		tax   string
+	}{
+		{New(85, 0), New(15, -2), RoundBankers, "100", "15"},
+		{New(100, 0), New(15, -2), RoundBankers, "117.65", "17.65"},
+		{New(100, 0), New(15, -2), RoundTruncate, "117.64", "17.64"},
+		{New(100, 0), Zero(), RoundBankers, "100", "0"},
+	}
+
+	for _, test := range tests {
+		gross, tax := GrossUp(test.net, test.rate, -2, test.rule)
+		assert.Equal(t, test.gross, gross.String())
+		assert.Equal(t, test.tax, tax.String())
+		assert.True(t, test.net.Add(tax).Equal(gross))
+	}
+
+	assert.Panics(t, func() { GrossUp(One, One, -2, RoundBankers) })
+}
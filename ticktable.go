@@ -0,0 +1,100 @@
+package decimal
+
+import (
+	"fmt"
+	"sync"
+)
+
+// TickSize holds tick size and price bounds of an instrument. Valid prices
+// are multiples of Tick within [Min, Max].
+type TickSize struct {
+	Tick Number
+	Min  Number
+	Max  Number // Max is the highest valid price, zero disables the bound
+}
+
+// Validate checks if price is a valid price of the instrument. Returned error
+// matches ErrInvalidPrice.
+func (s TickSize) Validate(price Number) error {
+	if price.Cmp(s.Min) < 0 || !s.Max.IsZero() && price.Cmp(s.Max) > 0 {
+		return fmt.Errorf("%w: %s is out of range", ErrInvalidPrice, DebugString(price))
+	}
+	if !price.Mod(s.Tick).IsZero() {
+		return fmt.Errorf("%w: %s is not a multiple of tick %s", ErrInvalidPrice, DebugString(price), DebugString(s.Tick))
+	}
+	return nil
+}
+
+// Snap rounds price to a multiple of Tick using the given rounding rule and
+// clamps it to [Min, Max].
+func (s TickSize) Snap(price Number, rule RoundRule) Number {
+	snapped := divRound(price, s.Tick, 0, rule).Mul(s.Tick)
+	if snapped.Cmp(s.Min) < 0 {
+		return s.Min
+	}
+	if !s.Max.IsZero() && snapped.Cmp(s.Max) > 0 {
+		return s.Max
+	}
+	return snapped
+}
+
+// TickTable is a registry of tick sizes by instrument or market identifier.
+// TickTable is safe for concurrent use.
+type TickTable struct {
+	mu    sync.RWMutex
+	sizes map[string]TickSize
+}
+
+// NewTickTable creates an empty tick table.
+func NewTickTable() *TickTable {
+	return &TickTable{sizes: make(map[string]TickSize)}
+}
+
+// Register sets tick size of an instrument. Tick must be positive, Min and
+// non-zero Max must be multiples of the tick and Min must not exceed Max.
+// Returned error matches ErrInvalidPrice.
+func (t *TickTable) Register(instrument string, size TickSize) error {
+	if !size.Tick.IsPositive() {
+		return fmt.Errorf("%w: tick %s of %s must be positive", ErrInvalidPrice, DebugString(size.Tick), instrument)
+	}
+	if !size.Min.Mod(size.Tick).IsZero() || !size.Max.Mod(size.Tick).IsZero() {
+		return fmt.Errorf("%w: bounds of %s are not multiples of tick %s", ErrInvalidPrice, instrument, DebugString(size.Tick))
+	}
+	if !size.Max.IsZero() && size.Min.Cmp(size.Max) > 0 {
+		return fmt.Errorf("%w: min %s of %s exceeds max %s", ErrInvalidPrice, DebugString(size.Min), instrument, DebugString(size.Max))
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.sizes[instrument] = size
+	return nil
+}
+
+// Lookup returns tick size of an instrument.
+func (t *TickTable) Lookup(instrument string) (TickSize, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	size, ok := t.sizes[instrument]
+	return size, ok
+}
+
+// Validate checks if price is a valid price of an instrument, see
+// TickSize.Validate. ErrUnknownInstrument is returned for instruments that
+// were not registered.
+func (t *TickTable) Validate(instrument string, price Number) error {
+	size, ok := t.Lookup(instrument)
+	if !ok {
+		return ErrUnknownInstrument
+	}
+	return size.Validate(price)
+}
+
+// SnapPrice snaps price to a valid price of an instrument, see TickSize.Snap.
+// ErrUnknownInstrument is returned for instruments that were not registered.
+func (t *TickTable) SnapPrice(instrument string, price Number, rule RoundRule) (Number, error) {
+	size, ok := t.Lookup(instrument)
+	if !ok {
+		return Number{}, ErrUnknownInstrument
+	}
+	return size.Snap(price, rule), nil
+}
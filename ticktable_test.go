@@ -0,0 +1,89 @@
+package decimal
+
+import (
+	"testing"
+
+	newDecimal "github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func testTickTable(t *testing.T) *TickTable {
+	table := NewTickTable()
+	assert.NoError(t, table.Register("EURUSD", TickSize{Tick: New(5, -5), Min: New(5, -1), Max: New(2, 0)}))
+	assert.NoError(t, table.Register("ES", TickSize{Tick: New(25, -2)}))
+	return table
+}
+
+func TestTickTableRegister(t *testing.T) {
+	table := NewTickTable()
+	assert.ErrorIs(t, table.Register("x", TickSize{}), ErrInvalidPrice)
+	assert.ErrorIs(t, table.Register("x", TickSize{Tick: New(5, -2), Min: New(1, -2)}), ErrInvalidPrice)
+	assert.ErrorIs(t, table.Register("x", TickSize{Tick: New(5, -2), Min: New(2, 0), Max: One}), ErrInvalidPrice)
+	assert.EqualError(t, table.Register("x", TickSize{Tick: New(5, -2), Min: New(2, 0), Max: One}),
+		`decimal: invalid price: min dec(coef=2, exp=0, "2") of x exceeds max dec(coef=1, exp=0, "1")`)
+
+	_, ok := table.Lookup("x")
+	assert.False(t, ok)
+
+	table = testTickTable(t)
+	size, ok := table.Lookup("ES")
+	assert.True(t, ok)
+	assert.Equal(t, New(25, -2), size.Tick)
+}
+
+func TestTickTableValidate(t *testing.T) {
+	table := testTickTable(t)
+	tests := []struct {
+		instrument string
+		price      string
+		err        error
+	}{
+		{"EURUSD", "1.08455", nil},
+		{"EURUSD", "1.0846", nil},
+		{"EURUSD", "1.08451", ErrInvalidPrice},
+		{"EURUSD", "2.00005", ErrInvalidPrice},
+		{"EURUSD", "0.4", ErrInvalidPrice},
+		{"ES", "4512.75", nil},
+		{"ES", "100000", nil},
+		{"ES", "4512.70", ErrInvalidPrice},
+		{"GBPUSD", "1.2", ErrUnknownInstrument},
+	}
+
+	for _, test := range tests {
+		err := table.Validate(test.instrument, newDecimal.RequireFromString(test.price))
+		if test.err == nil {
+			assert.NoError(t, err, test.price)
+		} else {
+			assert.ErrorIs(t, err, test.err, test.price)
+		}
+	}
+}
+
+func TestTickTableSnapPrice(t *testing.T) {
+	table := testTickTable(t)
+	tests := []struct {
+		instrument string
+		price      string
+		rule       RoundRule
+		expected   string
+	}{
+		{"EURUSD", "1.084512", RoundBankers, "1.0845"},
+		{"EURUSD", "1.084512", RoundCeil, "1.08455"},
+		{"EURUSD", "1.084575", RoundBankers, "1.0846"},
+		{"EURUSD", "5", RoundBankers, "2"},
+		{"EURUSD", "0.1", RoundBankers, "0.5"},
+		{"ES", "4512.8", RoundBankers, "4512.75"},
+		{"ES", "4512.875", RoundBankers, "4513"},
+		{"ES", "4512.875", RoundFloor, "4512.75"},
+	}
+
+	for _, test := range tests {
+		actual, err := table.SnapPrice(test.instrument, newDecimal.RequireFromString(test.price), test.rule)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, actual.String(), test.price)
+		assert.NoError(t, table.Validate(test.instrument, actual))
+	}
+
+	_, err := table.SnapPrice("GBPUSD", One, RoundBankers)
+	assert.ErrorIs(t, err, ErrUnknownInstrument)
+}
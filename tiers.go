@@ -0,0 +1,65 @@
+package decimal
+
+import (
+	"fmt"
+)
+
+// Tiers is a progressive rate schedule, e.g. commission or duty bands. N
+// breakpoints split amounts into N+1 bands: band 0 is [0, breakpoints[0]),
+// band i is [breakpoints[i-1], breakpoints[i]) and the last band has no upper
+// limit. Every band of an amount is charged at its own rate.
+type Tiers struct {
+	breakpoints []Number
+	rates       []Number
+}
+
+// NewTiers creates a rate schedule. Breakpoints must be positive and strictly
+// increasing, there must be exactly one rate more than breakpoints. Rates are
+// plain factors, i.e. 0.05 for 5%. Returned error matches ErrInvalidTiers.
+func NewTiers(breakpoints, rates []Number) (Tiers, error) {
+	if len(rates) != len(breakpoints)+1 {
+		return Tiers{}, fmt.Errorf("%w: %d breakpoints require %d rates", ErrInvalidTiers, len(breakpoints), len(breakpoints)+1)
+	}
+	for i, b := range breakpoints {
+		if !b.IsPositive() || i > 0 && b.Cmp(breakpoints[i-1]) <= 0 {
+			return Tiers{}, fmt.Errorf("%w: breakpoint %s", ErrInvalidTiers, DebugString(b))
+		}
+	}
+
+	t := Tiers{
+		breakpoints: make([]Number, len(breakpoints)),
+		rates:       make([]Number, len(rates)),
+	}
+	copy(t.breakpoints, breakpoints)
+	copy(t.rates, rates)
+	return t, nil
+}
+
+// Apply calculates charge of an amount and its split by bands. The total is
+// the exact sum of all bands rounded once to the given exponent using the
+// given rounding rule. Band charges are rounded cumulatively, i.e. band i is
+// the rounded sum of bands 0 through i minus the rounded sum of bands 0
+// through i-1, so they always add up to the total exactly. Non-positive
+// amounts are not charged.
+func (t Tiers) Apply(amount Number, exp int, rule RoundRule) (total Number, perTier []Number) {
+	perTier = make([]Number, len(t.rates))
+	exact, rounded := Zero(), Round(Zero(), exp, rule)
+	lower := Zero()
+	for i, rate := range t.rates {
+		prev := rounded
+		if amount.Cmp(lower) > 0 {
+			upper := amount
+			if i < len(t.breakpoints) && t.breakpoints[i].Cmp(amount) < 0 {
+				upper = t.breakpoints[i]
+			}
+			exact = exact.Add(upper.Sub(lower).Mul(rate))
+			rounded = Round(exact, exp, rule)
+		}
+		perTier[i] = rounded.Sub(prev)
+
+		if i < len(t.breakpoints) {
+			lower = t.breakpoints[i]
+		}
+	}
+	return rounded, perTier
+}
@@ -0,0 +1,70 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTiersInvalid(t *testing.T) {
+	tests := []struct {
+		breakpoints []Number
+		rates       []Number
+	}{
+		{nil, nil},
+		{[]Number{New(100, 0)}, []Number{New(1, -1)}},
+		{[]Number{Zero()}, []Number{New(1, -1), New(2, -1)}},
+		{[]Number{New(100, 0), New(100, 0)}, []Number{New(1, -1), New(2, -1), New(3, -1)}},
+	}
+
+	for _, test := range tests {
+		_, err := NewTiers(test.breakpoints, test.rates)
+		assert.ErrorIs(t, err, ErrInvalidTiers)
+	}
+}
+
+func TestTiersApply(t *testing.T) {
+	// 10% up to 100, 5% up to 1000, 2% above
+	tiers, err := NewTiers(
+		[]Number{New(100, 0), New(1000, 0)},
+		[]Number{New(10, -2), New(5, -2), New(2, -2)},
+	)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		amount  Number
+		total   string
+		perTier []string
+	}{
+		{New(50, 0), "5", []string{"5", "0", "0"}},
+		{New(100, 0), "10", []string{"10", "0", "0"}},
+		{New(10001, -2), "10", []string{"10", "0", "0"}},
+		{New(10020, -2), "10.01", []string{"10", "0.01", "0"}},
+		{New(1500, 0), "65", []string{"10", "45", "10"}},
+		{Zero(), "0", []string{"0", "0", "0"}},
+		{New(-10, 0), "0", []string{"0", "0", "0"}},
+	}
+
+	for _, test := range tests {
+		total, perTier := tiers.Apply(test.amount, -2, RoundBankers)
+		assert.Equal(t, test.total, total.String(), test.amount.String())
+		assert.Equal(t, test.perTier, numberStrings(perTier), test.amount.String())
+	}
+}
+
+func TestTiersApplyReconciles(t *testing.T) {
+	// 3.333% up to 1, 6.667% above
+	tiers, err := NewTiers([]Number{One}, []Number{New(3333, -5), New(6667, -5)})
+	assert.NoError(t, err)
+
+	total, perTier := tiers.Apply(Two, -2, RoundBankers)
+	assert.Equal(t, "0.1", total.String())
+	assert.Equal(t, []string{"0.03", "0.07"}, numberStrings(perTier))
+
+	// exact bands 0.03333 and 0.033335 rounded independently would add up to
+	// 0.06 instead of the total 0.07
+	total, perTier = tiers.Apply(New(15, -1), -2, RoundBankers)
+	assert.Equal(t, "0.07", total.String())
+	assert.Equal(t, []string{"0.03", "0.04"}, numberStrings(perTier))
+	assert.True(t, sumExact(perTier).Equal(total))
+}
@@ -0,0 +1,68 @@
+package decimal
+
+import (
+	"container/heap"
+)
+
+// TopK returns k largest values in descending order. If values has fewer than
+// k elements, all of them are returned. Values are not modified, selection
+// uses a heap of size k and does not sort the whole slice.
+func TopK(values []Number, k int) []Number {
+	return selectK(values, k, 1)
+}
+
+// BottomK returns k smallest values in ascending order, see TopK.
+func BottomK(values []Number, k int) []Number {
+	return selectK(values, k, -1)
+}
+
+// selectK selects k largest values if sign is 1 or k smallest values if sign
+// is -1.
+func selectK(values []Number, k int, sign int) []Number {
+	if k <= 0 {
+		return []Number{}
+	}
+	if k > len(values) {
+		k = len(values)
+	}
+
+	// heap root is the worst of the selected values
+	h := &numberHeap{values: make([]Number, 0, k), sign: sign}
+	for _, v := range values {
+		if len(h.values) < k {
+			heap.Push(h, v)
+		} else if Cmp(v, h.values[0])*sign > 0 {
+			h.values[0] = v
+			heap.Fix(h, 0)
+		}
+	}
+
+	res := make([]Number, len(h.values))
+	for i := len(res) - 1; i >= 0; i-- {
+		res[i] = heap.Pop(h).(Number)
+	}
+	return res
+}
+
+// numberHeap implements heap.Interface, sign 1 makes it a min-heap and sign
+// -1 a max-heap.
+type numberHeap struct {
+	values []Number
+	sign   int
+}
+
+func (h *numberHeap) Len() int { return len(h.values) }
+
+func (h *numberHeap) Less(i, j int) bool {
+	return Cmp(h.values[i], h.values[j])*h.sign < 0
+}
+
+func (h *numberHeap) Swap(i, j int) { h.values[i], h.values[j] = h.values[j], h.values[i] }
+
+func (h *numberHeap) Push(x interface{}) { h.values = append(h.values, x.(Number)) }
+
+func (h *numberHeap) Pop() interface{} {
+	n := h.values[len(h.values)-1]
+	h.values = h.values[:len(h.values)-1]
+	return n
+}
@@ -0,0 +1,49 @@
+package decimal
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopK(t *testing.T) {
+	values := []Number{New(5, 0), New(-1, 0), New(35, -1), New(12, 0), New(0, 0), New(50, -1)}
+
+	assert.Equal(t, []string{"12", "5", "5"}, numberStrings(TopK(values, 3)))
+	assert.Equal(t, []string{"-1", "0", "3.5"}, numberStrings(BottomK(values, 3)))
+	assert.Equal(t, []string{"12", "5", "5", "3.5", "0", "-1"}, numberStrings(TopK(values, 10)))
+	assert.Empty(t, TopK(values, 0))
+	assert.Empty(t, BottomK(nil, 2))
+	assert.Equal(t, New(5, 0), values[0], "input not modified")
+}
+
+func TestTopKRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	values := make([]Number, 1000)
+	for i := range values {
+		values[i] = New(r.Int63n(2000)-1000, -2)
+	}
+
+	sorted := sortedCopy(values)
+	top := TopK(values, 25)
+	bottom := BottomK(values, 25)
+	for i := 0; i < 25; i++ {
+		assert.True(t, sorted[len(sorted)-1-i].Equal(top[i]))
+		assert.True(t, sorted[i].Equal(bottom[i]))
+	}
+}
+
+func BenchmarkTopK(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	values := make([]Number, 100000)
+	for i := range values {
+		values[i] = New(r.Int63(), -2)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = TopK(values, 10)
+	}
+}
@@ -0,0 +1,88 @@
+package decimal
+
+import (
+	"fmt"
+)
+
+// Total is an exact running total bounded by the range of a SQL
+// NUMERIC(Precision, Scale) column. Additions that would make the total not
+// fit the column are rejected, the total stays unchanged and the error is also
+// reported to the OnExceed callback. Total tracks the lowest and the highest
+// total observed. Zero value is an unbounded total starting at zero.
+type Total struct {
+	// Precision is the maximum number of significant digits, zero disables
+	// bound checks.
+	Precision int
+	// Scale is the maximum number of fractional digits.
+	Scale int
+	// OnExceed is called with the rejected amount and the error when an
+	// addition is rejected.
+	OnExceed func(n Number, err error)
+
+	sum      Number
+	min      Number
+	max      Number
+	observed bool
+}
+
+// Add adds n to the total. It returns an error matching ErrOverflow if the
+// new total has too many integer digits or ErrPrecisionLoss if it has too many
+// fractional digits.
+func (t *Total) Add(n Number) error {
+	sum := t.Value().Add(n)
+	if err := fitsNumeric(sum, t.Precision, t.Scale); err != nil {
+		if t.OnExceed != nil {
+			t.OnExceed(n, err)
+		}
+		return err
+	}
+
+	t.sum = sum
+	if !t.observed || sum.Cmp(t.min) < 0 {
+		t.min = sum
+	}
+	if !t.observed || sum.Cmp(t.max) > 0 {
+		t.max = sum
+	}
+	t.observed = true
+	return nil
+}
+
+// Value returns the current total.
+func (t *Total) Value() Number {
+	return ensureInitialized(t.sum)
+}
+
+// Min returns the lowest total observed after an addition, zero if nothing
+// was added.
+func (t *Total) Min() Number {
+	return ensureInitialized(t.min)
+}
+
+// Max returns the highest total observed after an addition, zero if nothing
+// was added.
+func (t *Total) Max() Number {
+	return ensureInitialized(t.max)
+}
+
+// fitsNumeric checks if n can be stored in NUMERIC(precision, scale) column
+// without rounding. Non-positive precision disables the check.
+func fitsNumeric(n Number, precision, scale int) error {
+	if precision <= 0 {
+		return nil
+	}
+	// digits are counted without rescaling, exponents might be extreme
+	digits, exp := SignificantDigits(n)
+	if digits == 0 {
+		return nil
+	}
+	if exp < int64(-scale) {
+		return fmt.Errorf("%w: %s has more than %d fractional digits", ErrPrecisionLoss, DebugString(n), scale)
+	}
+
+	// |n| < 10^(precision-scale)
+	if digits+exp > int64(precision-scale) {
+		return fmt.Errorf("%w: %s does not fit NUMERIC(%d,%d)", ErrOverflow, DebugString(n), precision, scale)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTotal(t *testing.T) {
+	var exceeded []Number
+	total := Total{
+		Precision: 5,
+		Scale:     2,
+		OnExceed:  func(n Number, err error) { exceeded = append(exceeded, n) },
+	}
+	assert.Equal(t, Zero(), total.Value())
+	assert.Equal(t, Zero(), total.Min())
+
+	assert.NoError(t, total.Add(New(50000, -2)))
+	assert.NoError(t, total.Add(New(-70000, -2)))
+	assert.NoError(t, total.Add(New(1000, -3)))
+	assert.Equal(t, "-199", total.Value().String())
+	assert.Equal(t, "-200", total.Min().String())
+	assert.Equal(t, "500", total.Max().String())
+
+	err := total.Add(New(1, -3))
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	err = total.Add(New(-80100, -2))
+	assert.ErrorIs(t, err, ErrOverflow)
+	assert.EqualError(t, err, `decimal: overflow: dec(coef=-1000000, exp=-3, "-1000") does not fit NUMERIC(5,2)`)
+	assert.NoError(t, total.Add(New(-80099, -2)))
+	assert.Equal(t, "-999.99", total.Value().String())
+	assert.Equal(t, "-999.99", total.Min().String())
+	assert.Equal(t, []Number{New(1, -3), New(-80100, -2)}, exceeded)
+}
+
+func TestTotalUnbounded(t *testing.T) {
+	var total Total
+	assert.NoError(t, total.Add(New(1, 30)))
+	assert.NoError(t, total.Add(New(1, -30)))
+	assert.Equal(t, "1000000000000000000000000000000.000000000000000000000000000001", total.Value().String())
+	assert.True(t, total.Min().Equal(New(1, 30)))
+}
+
+func TestFitsNumeric(t *testing.T) {
+	assert.NoError(t, fitsNumeric(New(99999, -2), 5, 2))
+	assert.NoError(t, fitsNumeric(New(-9999900, -4), 5, 2))
+	assert.ErrorIs(t, fitsNumeric(New(1, 3), 5, 2), ErrOverflow)
+	assert.ErrorIs(t, fitsNumeric(New(1, 0), 2, 2), ErrOverflow)
+	assert.NoError(t, fitsNumeric(New(99, -2), 2, 2))
+	assert.ErrorIs(t, fitsNumeric(New(1, -3), 5, 2), ErrPrecisionLoss)
+	assert.EqualError(t, fitsNumeric(New(1, -3), 5, 2), `decimal: precision loss: dec(coef=1, exp=-3, "0.001") has more than 2 fractional digits`)
+	assert.NoError(t, fitsNumeric(New(1, 10), 12, 0))
+	assert.NoError(t, fitsNumeric(New(10000, -4), 1, 0))
+	assert.NoError(t, fitsNumeric(New(0, -2147483648), 1, 0))
+	assert.ErrorIs(t, fitsNumeric(New(1, -2147483648), 5, 2), ErrPrecisionLoss)
+}
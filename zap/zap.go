@@ -0,0 +1,46 @@
+// Package zap implements helpers for logging decimal numbers with
+// go.uber.org/zap. Numbers are always encoded as exact decimal strings, never
+// as floats.
+package zap
+
+import (
+	"github.com/advbet/decimal/v2"
+	uzap "go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Number constructs a field holding the exact decimal string of n.
+func Number(key string, n decimal.Number) uzap.Field {
+	return uzap.String(key, decimal.String(n))
+}
+
+// Numbers constructs a field holding a list of exact decimal strings.
+func Numbers(key string, ns []decimal.Number) uzap.Field {
+	return uzap.Array(key, Array(ns))
+}
+
+// Array is a list of decimal numbers implementing the zapcore.ArrayMarshaler
+// interface.
+type Array []decimal.Number
+
+// MarshalLogArray implements the zapcore.ArrayMarshaler interface.
+func (a Array) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, n := range a {
+		enc.AppendString(decimal.String(n))
+	}
+	return nil
+}
+
+// Object is a decimal number implementing the zapcore.ObjectMarshaler
+// interface, it is encoded as an object holding the exact decimal string,
+// coefficient and exponent, e.g. {"value":"12.34","coef":"1234","exp":-2}.
+type Object decimal.Number
+
+// MarshalLogObject implements the zapcore.ObjectMarshaler interface.
+func (o Object) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	n := decimal.Number(o)
+	enc.AddString("value", decimal.String(n))
+	enc.AddString("coef", n.Coefficient().String())
+	enc.AddInt32("exp", n.Exponent())
+	return nil
+}
@@ -0,0 +1,38 @@
+package zap
+
+import (
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	"github.com/stretchr/testify/assert"
+	uzap "go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestFields(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	logger := uzap.New(core)
+
+	logger.Info("bet placed",
+		Number("stake", decimal.New(1050, -2)),
+		Numbers("odds", []decimal.Number{decimal.New(15, -1), {}}),
+		uzap.Object("payout", Object(decimal.New(-1234, -2))),
+	)
+
+	fields := logs.All()[0].ContextMap()
+	assert.Equal(t, "10.5", fields["stake"])
+	assert.Equal(t, []interface{}{"1.5", "0"}, fields["odds"])
+	assert.Equal(t, map[string]interface{}{
+		"value": "-12.34",
+		"coef":  "-1234",
+		"exp":   int32(-2),
+	}, fields["payout"])
+}
+
+func TestObjectZeroValue(t *testing.T) {
+	enc := zapcore.NewMapObjectEncoder()
+	assert.NoError(t, Object(decimal.Number{}).MarshalLogObject(enc))
+	assert.Equal(t, "0", enc.Fields["value"])
+	assert.Equal(t, int32(0), enc.Fields["exp"])
+}
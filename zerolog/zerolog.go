@@ -0,0 +1,44 @@
+// Package zerolog implements helpers for logging decimal numbers with
+// github.com/rs/zerolog. Numbers are always encoded as exact decimal strings,
+// never as floats.
+package zerolog
+
+import (
+	"github.com/advbet/decimal/v2"
+	"github.com/rs/zerolog"
+)
+
+// Number adds the field key with the exact decimal string of n to the event.
+func Number(e *zerolog.Event, key string, n decimal.Number) *zerolog.Event {
+	return e.Str(key, decimal.String(n))
+}
+
+// Numbers adds the field key with a list of exact decimal strings to the
+// event.
+func Numbers(e *zerolog.Event, key string, ns []decimal.Number) *zerolog.Event {
+	return e.Array(key, Array(ns))
+}
+
+// Array is a list of decimal numbers implementing the
+// zerolog.LogArrayMarshaler interface.
+type Array []decimal.Number
+
+// MarshalZerologArray implements the zerolog.LogArrayMarshaler interface.
+func (a Array) MarshalZerologArray(arr *zerolog.Array) {
+	for _, n := range a {
+		arr.Str(decimal.String(n))
+	}
+}
+
+// Object is a decimal number implementing the zerolog.LogObjectMarshaler
+// interface, it is encoded as an object holding the exact decimal string,
+// coefficient and exponent, e.g. {"value":"12.34","coef":"1234","exp":-2}.
+type Object decimal.Number
+
+// MarshalZerologObject implements the zerolog.LogObjectMarshaler interface.
+func (o Object) MarshalZerologObject(e *zerolog.Event) {
+	n := decimal.Number(o)
+	e.Str("value", decimal.String(n)).
+		Str("coef", n.Coefficient().String()).
+		Int32("exp", n.Exponent())
+}
@@ -0,0 +1,34 @@
+package zerolog
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/advbet/decimal/v2"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+
+	e := logger.Info()
+	e = Number(e, "stake", decimal.New(1050, -2))
+	e = Numbers(e, "odds", []decimal.Number{decimal.New(15, -1), {}})
+	e.Object("payout", Object(decimal.New(-1234, -2))).Send()
+
+	assert.JSONEq(t, `{
+		"level": "info",
+		"stake": "10.5",
+		"odds": ["1.5", "0"],
+		"payout": {"value": "-12.34", "coef": "-1234", "exp": -2}
+	}`, buf.String())
+}
+
+func TestObjectZeroValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+	logger.Log().Object("n", Object(decimal.Number{})).Send()
+	assert.JSONEq(t, `{"n": {"value": "0", "coef": "0", "exp": 0}}`, buf.String())
+}